@@ -0,0 +1,16 @@
+data "f5os_api_stats" "session" {
+  slowest_count = 3
+  depends_on    = [f5os_tenant.tenant, f5os_vlan.vlan10]
+}
+
+output "api_calls" {
+  value = data.f5os_api_stats.session.calls
+}
+
+output "api_failure_rate" {
+  value = data.f5os_api_stats.session.failure_rate
+}
+
+output "slowest_api_endpoints" {
+  value = data.f5os_api_stats.session.slowest_endpoints
+}
@@ -0,0 +1,5 @@
+data "f5os_available_upgrades" "example" {}
+
+output "upgrade_available" {
+  value = data.f5os_available_upgrades.example.latest_version != ""
+}
@@ -0,0 +1,8 @@
+data "f5os_blade_software_versions" "partition" {
+  partition        = "TerraformPartition"
+  expected_version = "1.6.0-9817"
+}
+
+output "upgrade_stragglers" {
+  value = data.f5os_blade_software_versions.partition.stragglers
+}
@@ -0,0 +1,6 @@
+data "f5os_blades" "chassis" {
+}
+
+output "free_slots" {
+  value = [for blade in data.f5os_blades.chassis.blades : blade.slot_num if blade.partition == "none"]
+}
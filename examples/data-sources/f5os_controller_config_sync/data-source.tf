@@ -0,0 +1,6 @@
+data "f5os_controller_config_sync" "sync" {
+}
+
+output "controllers_in_sync" {
+  value = data.f5os_controller_config_sync.sync.in_sync
+}
@@ -0,0 +1,8 @@
+data "f5os_imports" "partition" {}
+
+# Write the import blocks of the VLANs, interfaces and LAGs of the partition, then run
+# terraform plan -generate-config-out=generated.tf to generate their configuration.
+resource "local_file" "imports" {
+  filename = "${path.module}/imports.tf"
+  content  = data.f5os_imports.partition.import_blocks
+}
@@ -0,0 +1,12 @@
+data "f5os_interface_error_rates" "uplinks" {
+  names           = ["1.0", "2.0"]
+  sample_interval = 30
+  threshold       = 0.0001
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.above_threshold) == 0
+      error_message = "Uplinks with errors or discards: ${join(", ", self.above_threshold)}"
+    }
+  }
+}
@@ -0,0 +1,7 @@
+data "f5os_interface_ifindex" "ports" {
+  names = ["1.0", "2.0"]
+}
+
+output "uplink_ifindex" {
+  value = data.f5os_interface_ifindex.ports.ifindexes["1.0"]
+}
@@ -0,0 +1,9 @@
+data "f5os_interfaces" "ports" {
+  type = "ethernetCsmacd"
+}
+
+resource "f5os_interface" "ports" {
+  for_each    = toset(data.f5os_interfaces.ports.names)
+  name        = each.value
+  native_vlan = 100
+}
@@ -0,0 +1,14 @@
+data "f5os_orphans" "partition" {
+  name_prefix = "tf-"
+  managed_ids = concat([for t in f5os_tenant.all : t.id], [for v in f5os_vlan.all : v.id])
+}
+
+# Fail the pipeline when an interrupted apply left objects behind
+output "orphans" {
+  value = data.f5os_orphans.partition.orphans
+
+  precondition {
+    condition     = length(data.f5os_orphans.partition.orphans) == 0
+    error_message = "Objects left by a failed apply: ${join(", ", data.f5os_orphans.partition.orphans[*].name)}"
+  }
+}
\ No newline at end of file
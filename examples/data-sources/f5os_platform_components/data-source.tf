@@ -0,0 +1,6 @@
+data "f5os_platform_components" "inventory" {
+}
+
+output "serial_numbers" {
+  value = { for component in data.f5os_platform_components.inventory.components : component.name => component.serial_no if component.serial_no != "" }
+}
@@ -0,0 +1,7 @@
+data "f5os_primary_key" "target" {
+  expected_hash = var.backup_primary_key_hash
+}
+
+output "backup_restorable" {
+  value = data.f5os_primary_key.target.backups_compatible
+}
@@ -0,0 +1,5 @@
+data "f5os_qkviews" "stored" {}
+
+output "qkviews_total_size" {
+  value = sum(concat([0], [for qkview in data.f5os_qkviews.stored.qkviews : qkview.size]))
+}
@@ -0,0 +1,7 @@
+data "f5os_restconf" "hostname" {
+  path = "/openconfig-system:system/state/hostname"
+}
+
+output "hostname" {
+  value = jsondecode(data.f5os_restconf.hostname.response)["openconfig-system:hostname"]
+}
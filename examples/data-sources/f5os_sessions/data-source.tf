@@ -0,0 +1,5 @@
+data "f5os_sessions" "active" {}
+
+output "logged_in_users" {
+  value = distinct([for session in data.f5os_sessions.active.sessions : session.username])
+}
@@ -0,0 +1,13 @@
+data "f5os_system_settings" "system" {}
+
+output "tenant_dns_servers" {
+  value = data.f5os_system_settings.system.dns_servers
+}
+
+output "tenant_ntp_servers" {
+  value = data.f5os_system_settings.system.ntp_servers
+}
+
+output "tenant_timezone" {
+  value = data.f5os_system_settings.system.timezone
+}
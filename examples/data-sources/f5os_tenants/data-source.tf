@@ -0,0 +1,10 @@
+# The tenants of the web team
+data "f5os_tenants" "web" {
+  labels = {
+    team = "web"
+  }
+}
+
+output "web_tenants" {
+  value = data.f5os_tenants.web.names
+}
\ No newline at end of file
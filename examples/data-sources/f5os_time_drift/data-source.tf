@@ -0,0 +1,12 @@
+data "f5os_time_drift" "clock" {
+  max_drift = 60
+}
+
+resource "terraform_data" "license" {
+  lifecycle {
+    precondition {
+      condition     = data.f5os_time_drift.clock.within_tolerance
+      error_message = "The device clock is ${data.f5os_time_drift.clock.drift} seconds off, fix NTP before licensing."
+    }
+  }
+}
@@ -0,0 +1,22 @@
+# The VLANs of the tenants of the web team
+data "f5os_vlans" "web" {
+  labels = {
+    team = "web"
+  }
+}
+
+# A new tenant of the team on the same VLANs
+resource "f5os_tenant" "web3" {
+  name              = "web-3"
+  image_name        = "BIGIP-17.1.0-0.0.16.ALL-F5OS.qcow2.zip.bundle"
+  mgmt_ip           = "10.100.100.28"
+  mgmt_gateway      = "10.100.100.1"
+  mgmt_prefix       = 24
+  cpu_cores         = 4
+  nodes             = [1]
+  virtual_disk_size = 82
+  vlans             = data.f5os_vlans.web.vlan_ids
+  labels = {
+    team = "web"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,2 @@
+# Allowed IPs can be imported by specifying any identifier, every allowed IP entry of the system is adopted.
+terraform import f5os_allowed_ips.mgmt allowed-ips
\ No newline at end of file
@@ -0,0 +1,13 @@
+resource "f5os_allowed_ips" "mgmt" {
+  allowed = [
+    {
+      name   = "automation"
+      prefix = "10.192.10.0/24"
+    },
+    {
+      name   = "jumphost"
+      prefix = "2001:db8::10"
+      port   = 443
+    }
+  ]
+}
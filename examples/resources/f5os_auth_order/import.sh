@@ -0,0 +1,2 @@
+# Authentication order can be imported by specifying the fixed identifier.
+terraform import f5os_auth_order.order auth-order
\ No newline at end of file
@@ -0,0 +1,8 @@
+resource "f5os_auth_order" "order" {
+  methods = ["radius", "ldap", "local"]
+
+  depends_on = [
+    f5os_auth_server_group.radius,
+    f5os_auth_server_group.ldap,
+  ]
+}
@@ -0,0 +1,2 @@
+# Server groups can be imported by specifying the server group name.
+terraform import f5os_auth_server_group.radius radius-servers
\ No newline at end of file
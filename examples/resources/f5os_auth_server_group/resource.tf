@@ -0,0 +1,27 @@
+resource "f5os_auth_server_group" "radius" {
+  name = "radius-servers"
+  type = "radius"
+  servers = [
+    {
+      address = "192.0.2.11"
+      secret  = var.radius_secret
+    },
+    {
+      address = "192.0.2.12"
+      port    = 1645
+      secret  = var.radius_secret
+    }
+  ]
+}
+
+resource "f5os_auth_server_group" "ldap" {
+  name = "ldap-servers"
+  type = "ldap"
+  servers = [
+    {
+      address = "ldap.example.com"
+      port    = 636
+      ldaps   = true
+    }
+  ]
+}
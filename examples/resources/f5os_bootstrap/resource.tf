@@ -0,0 +1,24 @@
+resource "f5os_bootstrap" "first_boot" {
+  old_password = "admin"
+  new_password = var.admin_password
+  primary_key = {
+    passphrase = var.primary_key_passphrase
+    salt       = var.primary_key_salt
+  }
+  hostname           = "appliance-1.example.net"
+  dns_servers        = ["192.0.2.53", "192.0.2.54"]
+  dns_search_domains = ["example.net"]
+  ntp_servers        = ["192.0.2.123"]
+  allowed_ips = [
+    {
+      name   = "automation"
+      prefix = "10.192.10.0/24"
+    }
+  ]
+}
+
+resource "f5os_vlan" "vlan_10" {
+  vlan_id    = 10
+  name       = "internal"
+  depends_on = [f5os_bootstrap.first_boot]
+}
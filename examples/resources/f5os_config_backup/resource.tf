@@ -5,4 +5,13 @@ resource "f5os_config_backup" "test" {
   remote_password = "password"
   remote_path     = "/upload/test_cfg_backup"
   protocol        = "https"
-}
\ No newline at end of file
+}
+
+# snapshot the configuration to a local file before every apply
+resource "f5os_config_backup" "pre_apply" {
+  name       = "pre_apply_backup"
+  local_path = "${path.module}/backups/pre_apply_backup"
+  triggers = {
+    applied_at = timestamp()
+  }
+}
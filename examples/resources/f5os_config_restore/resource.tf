@@ -0,0 +1,6 @@
+resource "f5os_config_restore" "known_good" {
+  name = "test_cfg_backup"
+  triggers = {
+    change_ticket = "CHG0042"
+  }
+}
@@ -0,0 +1,2 @@
+# DNS configuration can be imported by specifying any identifier, the servers and search domains of the system are adopted.
+terraform import f5os_dns.dns dns
\ No newline at end of file
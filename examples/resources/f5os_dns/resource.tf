@@ -0,0 +1,4 @@
+resource "f5os_dns" "dns" {
+  servers        = ["192.0.2.53", "2001:db8::53"]
+  search_domains = ["example.com"]
+}
@@ -0,0 +1,2 @@
+# DNS host entries can be imported by specifying their hostname.
+terraform import f5os_dns_host.ntp ntp.example.net
\ No newline at end of file
@@ -0,0 +1,6 @@
+resource "f5os_dns_host" "ntp" {
+  hostname       = "ntp.example.net"
+  ipv4_addresses = ["192.0.2.123"]
+  ipv6_addresses = ["2001:db8::123"]
+  aliases        = ["ntp"]
+}
@@ -0,0 +1,2 @@
+# Interface descriptions can be imported by specifying any identifier, every interface with a description is adopted.
+terraform import f5os_interface_descriptions.cabling interface-descriptions
\ No newline at end of file
@@ -0,0 +1,6 @@
+# Labels the ports of the system from a cabling sheet kept next to the configuration
+resource "f5os_interface_descriptions" "cabling" {
+  descriptions = {
+    for row in csvdecode(file("${path.module}/cabling.csv")) : row.port => "${row.peer} ${row.peer_port}"
+  }
+}
@@ -0,0 +1,2 @@
+# Remote logging can be imported by specifying any identifier, the TLS certificate and CA bundles are not returned by the system and are not imported.
+terraform import f5os_logging.syslog logging
\ No newline at end of file
@@ -0,0 +1,40 @@
+resource "f5os_logging" "syslog" {
+  servers = [
+    {
+      address = "192.0.2.10"
+      logs = [
+        {
+          facility = "local0"
+          severity = "informational"
+        },
+        {
+          facility = "authpriv"
+          severity = "notice"
+        }
+      ]
+    },
+    # TLS log forwarding
+    {
+      address        = "syslog.example.com"
+      port           = 6514
+      protocol       = "tcp"
+      authentication = true
+      logs = [
+        {
+          facility = "local0"
+          severity = "error"
+        }
+      ]
+    }
+  ]
+  tls = {
+    certificate = file("syslog-client.crt")
+    key         = file("syslog-client.key")
+  }
+  ca_bundles = [
+    {
+      name    = "syslog-ca"
+      content = file("syslog-ca.pem")
+    }
+  ]
+}
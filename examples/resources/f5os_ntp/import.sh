@@ -0,0 +1,2 @@
+# NTP configuration can be imported by specifying any identifier, the key values are not returned by the system and stay empty.
+terraform import f5os_ntp.ntp ntp
\ No newline at end of file
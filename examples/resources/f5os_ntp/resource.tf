@@ -0,0 +1,42 @@
+resource "f5os_ntp" "ntp" {
+  authentication = true
+  servers = [
+    {
+      address = "192.0.2.123"
+      key_id  = 10
+      prefer  = true
+      iburst  = true
+    },
+    {
+      address = "ntp.example.com"
+      key_id  = 10
+    }
+  ]
+  keys = [
+    {
+      id    = 10
+      type  = "sha256"
+      value = var.ntp_key
+    }
+  ]
+}
+
+# On VELOS chassis, the partitions use the keys of the controller
+resource "f5os_ntp" "partition" {
+  provider       = f5os.partition
+  authentication = true
+  servers = [
+    {
+      address = "192.0.2.123"
+      key_id  = 10
+    }
+  ]
+  keys = [
+    {
+      id    = 10
+      type  = "sha256"
+      value = var.partition_ntp_key
+    }
+  ]
+  match_keys_fingerprint = f5os_ntp.ntp.keys_fingerprint
+}
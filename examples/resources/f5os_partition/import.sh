@@ -0,0 +1,2 @@
+# Partition can be imported by specifying the partition name.
+terraform import f5os_partition.velos-part testpartition
\ No newline at end of file
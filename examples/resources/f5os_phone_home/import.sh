@@ -0,0 +1,2 @@
+# Phone home settings can be imported by specifying the fixed identifier.
+terraform import f5os_phone_home.telemetry phone-home
\ No newline at end of file
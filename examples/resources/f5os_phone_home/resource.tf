@@ -0,0 +1,4 @@
+# Disables phone-home / usage telemetry on the F5OS platform
+resource "f5os_phone_home" "telemetry" {
+  enabled = false
+}
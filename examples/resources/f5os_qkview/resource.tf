@@ -0,0 +1,6 @@
+# Collects a qkview for a support case, destroying the resource deletes it from the device
+resource "f5os_qkview" "support_case" {
+  name          = "support-case-00412345.tar"
+  exclude_cores = true
+  timeout       = 1200
+}
@@ -0,0 +1,2 @@
+# RESTCONF resources can be imported by specifying the RESTCONF data path, the payload is set to the subtree of the device.
+terraform import f5os_restconf.banner /openconfig-system:system/config/login-banner
\ No newline at end of file
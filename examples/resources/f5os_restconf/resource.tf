@@ -0,0 +1,20 @@
+resource "f5os_restconf" "banner" {
+  path = "/openconfig-system:system/config/login-banner"
+  payload = jsonencode({
+    "openconfig-system:login-banner" = "Authorized use only"
+  })
+}
+
+# Create a list entry with POST, the payload is sent to the parent of the path
+resource "f5os_restconf" "snmp_community" {
+  path          = "/openconfig-system:system/f5-system-snmp:snmp/communities/community=monitoring"
+  create_method = "POST"
+  payload = jsonencode({
+    "f5-system-snmp:community" = [
+      {
+        name   = "monitoring"
+        config = { name = "monitoring", "security-model" = ["v2c"] }
+      }
+    ]
+  })
+}
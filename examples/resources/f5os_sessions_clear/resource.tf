@@ -0,0 +1,7 @@
+# Logs out every session of the automation user after its password is rotated
+resource "f5os_sessions_clear" "rotation" {
+  usernames = ["automation"]
+  triggers = {
+    password_version = "2023-10"
+  }
+}
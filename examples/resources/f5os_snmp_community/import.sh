@@ -0,0 +1,2 @@
+# SNMP communities can be imported by specifying the community name.
+terraform import f5os_snmp_community.monitoring monitoring
\ No newline at end of file
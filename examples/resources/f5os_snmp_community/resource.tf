@@ -0,0 +1,4 @@
+resource "f5os_snmp_community" "monitoring" {
+  name           = "monitoring"
+  security_model = ["v1", "v2c"]
+}
@@ -0,0 +1,2 @@
+# SNMP MIB objects can be imported by specifying any identifier.
+terraform import f5os_snmp_mib.mib snmp-mib
\ No newline at end of file
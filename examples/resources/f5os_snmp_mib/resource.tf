@@ -0,0 +1,5 @@
+resource "f5os_snmp_mib" "mib" {
+  sysname     = "rseries-lab-01"
+  syscontact  = "netops@example.com"
+  syslocation = "Lab rack 12"
+}
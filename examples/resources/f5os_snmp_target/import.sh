@@ -0,0 +1,2 @@
+# SNMP trap targets can be imported by specifying the target name.
+terraform import f5os_snmp_target.nms_v2c nms-v2c
\ No newline at end of file
@@ -0,0 +1,13 @@
+resource "f5os_snmp_target" "nms_v2c" {
+  name           = "nms-v2c"
+  address        = "192.0.2.162"
+  community      = f5os_snmp_community.monitoring.name
+  security_model = "v2c"
+}
+
+resource "f5os_snmp_target" "nms_v3" {
+  name    = "nms-v3"
+  address = "2001:db8::162"
+  port    = 10162
+  user    = f5os_snmp_user.monitoring.name
+}
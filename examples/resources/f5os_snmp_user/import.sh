@@ -0,0 +1,2 @@
+# SNMP users can be imported by specifying the user name, the passwords are not imported.
+terraform import f5os_snmp_user.monitoring monitoring
\ No newline at end of file
@@ -0,0 +1,7 @@
+resource "f5os_snmp_user" "monitoring" {
+  name             = "monitoring"
+  auth_protocol    = "sha"
+  auth_password    = var.snmp_auth_password
+  privacy_protocol = "aes"
+  privacy_password = var.snmp_privacy_password
+}
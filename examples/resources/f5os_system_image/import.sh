@@ -0,0 +1,2 @@
+# System image can be imported by specifying the ISO image name, the upload or transfer source is not imported.
+terraform import f5os_system_image.v18 F5OS-A-1.8.0-13497.R5R10.iso
@@ -0,0 +1,12 @@
+# Import a system ISO image from a remote server
+resource "f5os_system_image" "v18" {
+  image_name  = "F5OS-A-1.8.0-13497.R5R10.iso"
+  remote_host = "files.example.com"
+  remote_path = "f5os/images"
+}
+
+# Upload a system ISO image from the local machine
+resource "f5os_system_image" "v17" {
+  image_name       = "F5OS-A-1.7.0-3518.R5R10.iso"
+  upload_from_path = "/var/images"
+}
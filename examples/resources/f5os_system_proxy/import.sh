@@ -0,0 +1,2 @@
+# System proxy can be imported by specifying the fixed identifier.
+terraform import f5os_system_proxy.proxy system-proxy
\ No newline at end of file
@@ -0,0 +1,6 @@
+# Manages the HTTPS proxy used by the F5OS system for phone-home and licensing
+resource "f5os_system_proxy" "proxy" {
+  https_proxy = "http://proxy.example.com:3128"
+  username    = "proxyuser"
+  password    = "proxypass"
+}
@@ -0,0 +1,2 @@
+# System upgrade can be imported with any identifier, its version is the version the system runs.
+terraform import f5os_system_upgrade.upgrade upgrade
@@ -0,0 +1,11 @@
+resource "f5os_system_image" "v18" {
+  image_name  = "F5OS-A-1.8.0-13497.R5R10.iso"
+  remote_host = "files.example.com"
+  remote_path = "f5os/images"
+}
+
+# Upgrade the system to the imported image
+resource "f5os_system_upgrade" "upgrade" {
+  version = f5os_system_image.v18.version
+  timeout = 5400
+}
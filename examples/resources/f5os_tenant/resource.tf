@@ -11,4 +11,16 @@ resource "f5os_tenant" "test3" {
   vlans             = [1, 2]
   running_state     = "deployed"
   virtual_disk_size = 82
-}
\ No newline at end of file
+  description       = "Web frontend"
+  labels = {
+    team = "web"
+    env  = "prod"
+  }
+}
+
+# Create a tenant from an existing one, for a blue/green rollout
+resource "f5os_tenant" "test3_green" {
+  name       = "testtenant-ecosys3-green"
+  clone_from = "testtenant-ecosys3"
+  mgmt_ip    = "10.100.100.27"
+}
@@ -0,0 +1,2 @@
+# Tenant image can be imported by specifying the image name, the upload or transfer source is not imported.
+terraform import f5os_tenant_image.test BIGIP-17.1.0-0.0.16.ALL-F5OS.qcow2.zip.bundle
\ No newline at end of file
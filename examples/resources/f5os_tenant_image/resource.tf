@@ -5,4 +5,11 @@ resource "f5os_tenant_image" "test" {
   remote_path = "v17.1.0/daily/current/VM"
   local_path  = "images" ## for velos partition/rSeries appliance this path should be `images`/`images/tenant` respectively
   timeout     = 360
+}
+
+# Image stored under a name derived from its content, tenants reference it by image_id
+resource "f5os_tenant_image" "by_content" {
+  image_name       = "bigip-latest.qcow2.zip.bundle"
+  upload_from_path = "/var/images"
+  name_by_content  = true
 }
\ No newline at end of file
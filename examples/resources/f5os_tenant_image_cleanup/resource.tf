@@ -0,0 +1,7 @@
+# Removes tenant images not used by any tenant, keeping the 17.1 images
+resource "f5os_tenant_image_cleanup" "cleanup" {
+  keep_images = ["BIGIP-17.1*"]
+  triggers = {
+    run = "2023-10"
+  }
+}
@@ -0,0 +1,366 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AllowedIpsResource{}
+var _ resource.ResourceWithImportState = &AllowedIpsResource{}
+
+var allowedIpType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"name":   types.StringType,
+	"prefix": types.StringType,
+	"port":   types.Int64Type,
+}}
+
+func NewAllowedIpsResource() resource.Resource {
+	return &AllowedIpsResource{}
+}
+
+// AllowedIpsResource defines the resource implementation.
+type AllowedIpsResource struct {
+	client *f5ossdk.F5os
+}
+
+// AllowedIpsResourceModel describes the resource data model.
+type AllowedIpsResourceModel struct {
+	Allowed      types.List   `tfsdk:"allowed"`
+	AllowLockout types.Bool   `tfsdk:"allow_lockout"`
+	Id           types.String `tfsdk:"id"`
+}
+
+// AllowedIpModel describes an entry of the allowed list.
+type AllowedIpModel struct {
+	Name   types.String `tfsdk:"name"`
+	Prefix types.String `tfsdk:"prefix"`
+	Port   types.Int64  `tfsdk:"port"`
+}
+
+func (r *AllowedIpsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_allowed_ips"
+}
+
+func (r *AllowedIpsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource used to manage the allow-list of the addresses the management interfaces of F5OS systems can be reached from.\n\n" +
+			"~> **NOTE** Before changing the list, the resource checks that it still allows the addresses the device reports the RESTCONF sessions of the provider user as logged in from, " +
+			"and refuses to apply a list that would lock the provider out unless `allow_lockout` is set.",
+
+		Attributes: map[string]schema.Attribute{
+			"allowed": schema.ListNestedAttribute{
+				MarkdownDescription: "Entries of the allow-list.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the entry.",
+							Required:            true,
+						},
+						"prefix": schema.StringAttribute{
+							MarkdownDescription: "IPv4 or IPv6 address or CIDR prefix allowed, for example `192.0.2.0/24` or `2001:db8::/64`.",
+							Required:            true,
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "Port the prefix is allowed to reach, all ports when not set.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+					},
+				},
+			},
+			"allow_lockout": schema.BoolAttribute{
+				MarkdownDescription: "Whether a list that does not allow the address the provider connects from is applied anyway, default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AllowedIpsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *AllowedIpsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AllowedIpsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allowedConfig := getAllowedIpsConfig(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] Allowed IPs:%+v", allowedConfig.AllowedIPs.Allowed))
+	if !data.AllowLockout.ValueBool() {
+		r.checkLockout(allowedConfig, nil, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	_, err := r.client.AllowedIPsConfig(allowedConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Create Allowed IPs failed, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s-allowed-ips", r.client.Host))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AllowedIpsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AllowedIpsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var haveAllowed []AllowedIpModel
+	resp.Diagnostics.Append(data.Allowed.ElementsAs(ctx, &haveAllowed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	allowed := []AllowedIpModel{}
+	if data.Allowed.IsNull() {
+		// imported, every entry of the system is adopted
+		allowedIps, err := r.client.GetAllowedIPs()
+		if err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Allowed IPs, got error: %s", err))
+			return
+		}
+		for _, entry := range allowedIps {
+			allowed = append(allowed, allowedIpModelFromConfig(entry.Config, AllowedIpModel{}))
+		}
+	}
+	for _, entry := range haveAllowed {
+		allowedData, err := r.client.GetAllowedIP(entry.Name.ValueString())
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Allowed IP %s no longer on the system", entry.Name.ValueString()))
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Allowed IP %s, got error: %s", entry.Name.ValueString(), err))
+			return
+		}
+		if len(allowedData.Allowed) == 0 {
+			tflog.Warn(ctx, fmt.Sprintf("Allowed IP %s no longer on the system", entry.Name.ValueString()))
+			continue
+		}
+		allowed = append(allowed, allowedIpModelFromConfig(allowedData.Allowed[0].Config, entry))
+	}
+	if len(allowed) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	var diags diag.Diagnostics
+	data.Allowed, diags = types.ListValueFrom(ctx, allowedIpType, allowed)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AllowedIpsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *AllowedIpsResourceModel
+	var state *AllowedIpsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allowedConfig := getAllowedIpsConfig(ctx, data, &resp.Diagnostics)
+	var haveAllowed []AllowedIpModel
+	resp.Diagnostics.Append(state.Allowed.ElementsAs(ctx, &haveAllowed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	removed := stringListDifference(allowedIpNames(haveAllowed), allowedConfigNames(allowedConfig))
+	tflog.Info(ctx, fmt.Sprintf("[UPDATE] Allowed IPs:%+v, removing:%+v", allowedConfig.AllowedIPs.Allowed, removed))
+	if !data.AllowLockout.ValueBool() {
+		r.checkLockout(allowedConfig, removed, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	// the new entries are added before the old ones are removed, so that the allow-list
+	// never gets narrower than both of them while it changes
+	_, err := r.client.AllowedIPsConfig(allowedConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Update Allowed IPs failed, got error: %s", err))
+		return
+	}
+	for _, name := range removed {
+		if err := r.client.DeleteAllowedIP(name); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove Allowed IP %s, got error: %s", name, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AllowedIpsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AllowedIpsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var allowed []AllowedIpModel
+	resp.Diagnostics.Append(data.Allowed.ElementsAs(ctx, &allowed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, name := range allowedIpNames(allowed) {
+		if err := r.client.DeleteAllowedIP(name); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to Delete Allowed IP %s, got error: %s", name, err))
+			return
+		}
+	}
+}
+
+func (r *AllowedIpsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s-allowed-ips", r.client.Host))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_lockout"), false)...)
+}
+
+// checkLockout fails when the allow-list resulting from the merge of allowedConfig, and the removal
+// of the removed entries, would no longer allow an address the provider user is logged in from.
+func (r *AllowedIpsResource) checkLockout(allowedConfig *f5ossdk.F5ReqAllowedIPs, removed []string, diags *diag.Diagnostics) {
+	deviceAllowed, err := r.client.GetAllowedIPs()
+	if err != nil {
+		diags.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Allowed IPs, got error: %s", err))
+		return
+	}
+	replaced := make(map[string]bool)
+	for _, name := range append(removed, allowedConfigNames(allowedConfig)...) {
+		replaced[name] = true
+	}
+	var entries []f5ossdk.F5AllowedIPConfig
+	for _, entry := range deviceAllowed {
+		if !replaced[entry.Name] {
+			entries = append(entries, entry.Config)
+		}
+	}
+	for _, entry := range allowedConfig.AllowedIPs.Allowed {
+		entries = append(entries, entry.Config)
+	}
+	addrs, err := r.client.SessionSourceAddresses()
+	if err != nil {
+		diags.AddError("F5OS Client Error", fmt.Sprintf("Unable to detect the address the provider connects from, got error: %s", err))
+		return
+	}
+	if len(addrs) == 0 {
+		diags.AddError("Allowed IPs Lockout Check Failed",
+			fmt.Sprintf("The device reports no RESTCONF session of user %s, the address the provider connects from is unknown.\nSet `allow_lockout` to apply the allow-list without this check.", r.client.User))
+		return
+	}
+	var excluded []string
+	for _, addr := range addrs {
+		if !f5ossdk.AllowedIPsPermit(entries, addr, r.client.ApiPort()) {
+			excluded = append(excluded, addr.String())
+		}
+	}
+	if len(excluded) > 0 {
+		diags.AddError("Allowed IPs Lockout",
+			fmt.Sprintf("The allow-list does not allow %s, the address the provider connects from, to reach port %d and would lock the provider out of the device.\nAdd the address to `allowed`, or set `allow_lockout` to apply the allow-list anyway.", strings.Join(excluded, ", "), r.client.ApiPort()))
+	}
+}
+
+func getAllowedIpsConfig(ctx context.Context, data *AllowedIpsResourceModel, diags *diag.Diagnostics) *f5ossdk.F5ReqAllowedIPs {
+	var allowed []AllowedIpModel
+	diags.Append(data.Allowed.ElementsAs(ctx, &allowed, false)...)
+	allowedConfig := &f5ossdk.F5ReqAllowedIPs{}
+	for _, entry := range allowed {
+		entryConfig, err := f5ossdk.NewAllowedIPConfig(entry.Name.ValueString(), entry.Prefix.ValueString(), int(entry.Port.ValueInt64()))
+		if err != nil {
+			diags.AddError("Invalid Allowed IP", err.Error())
+			continue
+		}
+		allowedConfig.AllowedIPs.Allowed = append(allowedConfig.AllowedIPs.Allowed, f5ossdk.F5AllowedIP{Name: entryConfig.Name, Config: *entryConfig})
+	}
+	return allowedConfig
+}
+
+// allowedIpModelFromConfig returns the entry of the device, keeping the prefix of have when it is
+// the same prefix written differently, such as a bare host address.
+func allowedIpModelFromConfig(config f5ossdk.F5AllowedIPConfig, have AllowedIpModel) AllowedIpModel {
+	allowedPrefix := config.Ipv4
+	if allowedPrefix == nil {
+		allowedPrefix = config.Ipv6
+	}
+	entry := AllowedIpModel{Name: types.StringValue(config.Name), Prefix: have.Prefix, Port: types.Int64Null()}
+	if allowedPrefix == nil {
+		return entry
+	}
+	if allowedPrefix.Port != 0 {
+		entry.Port = types.Int64Value(int64(allowedPrefix.Port))
+	}
+	haveConfig, err := f5ossdk.NewAllowedIPConfig(config.Name, have.Prefix.ValueString(), allowedPrefix.Port)
+	if err == nil {
+		havePrefix := haveConfig.Ipv4
+		if havePrefix == nil {
+			havePrefix = haveConfig.Ipv6
+		}
+		if havePrefix.Address == allowedPrefix.Address && havePrefix.PrefixLength == allowedPrefix.PrefixLength {
+			return entry
+		}
+	}
+	entry.Prefix = types.StringValue(fmt.Sprintf("%s/%d", allowedPrefix.Address, allowedPrefix.PrefixLength))
+	return entry
+}
+
+func allowedIpNames(allowed []AllowedIpModel) []string {
+	var names []string
+	for _, entry := range allowed {
+		names = append(names, entry.Name.ValueString())
+	}
+	return names
+}
+
+func allowedConfigNames(allowedConfig *f5ossdk.F5ReqAllowedIPs) []string {
+	var names []string
+	for _, entry := range allowedConfig.AllowedIPs.Allowed {
+		names = append(names, entry.Name)
+	}
+	return names
+}
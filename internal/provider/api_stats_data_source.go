@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &ApiStatsDataSource{}
+)
+
+const defaultSlowestEndpoints = 5
+
+func NewApiStatsDataSource() datasource.DataSource {
+	return &ApiStatsDataSource{}
+}
+
+// ApiStatsDataSource defines the data source implementation.
+type ApiStatsDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// ApiStatsDataSourceModel describes the data source data model.
+type ApiStatsDataSourceModel struct {
+	ID                types.String       `tfsdk:"id"`
+	SlowestCount      types.Int64        `tfsdk:"slowest_count"`
+	Since             types.String       `tfsdk:"since"`
+	Calls             types.Int64        `tfsdk:"calls"`
+	Retries           types.Int64        `tfsdk:"retries"`
+	Failures          types.Int64        `tfsdk:"failures"`
+	FailureRate       types.Float64      `tfsdk:"failure_rate"`
+	AverageDurationMs types.Int64        `tfsdk:"average_duration_ms"`
+	SlowestEndpoints  []ApiEndpointModel `tfsdk:"slowest_endpoints"`
+}
+
+type ApiEndpointModel struct {
+	Method            types.String `tfsdk:"method"`
+	Path              types.String `tfsdk:"path"`
+	Calls             types.Int64  `tfsdk:"calls"`
+	Retries           types.Int64  `tfsdk:"retries"`
+	Failures          types.Int64  `tfsdk:"failures"`
+	MaxDurationMs     types.Int64  `tfsdk:"max_duration_ms"`
+	AverageDurationMs types.Int64  `tfsdk:"average_duration_ms"`
+}
+
+func (d *ApiStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_stats"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *ApiStatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the statistics of the API calls the provider made to the F5OS system since it was configured: call, retry and failure counts and the slowest endpoints. The data source itself makes no API call.\n\n" +
+			"Use `depends_on` on the resources of the configuration to read the statistics after them, such as to tune `retries`, `retry_interval` and the timeouts of the provider, or to spot slow devices. " +
+			"**NOTE** A data source depending on resources with pending changes is read during the apply.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"slowest_count": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Number of endpoints reported in `slowest_endpoints`, default is `%d`.", defaultSlowestEndpoints),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"since": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time the statistics are counted from, when the provider was configured, in RFC 3339 format.",
+			},
+			"calls": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of requests sent to the system, retries included.",
+			},
+			"retries": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of requests that were retries of failed ones.",
+			},
+			"failures": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of requests that failed, with a transport error or an HTTP status of 400 or more, `404` answering the lookups of absent objects excepted.",
+			},
+			"failure_rate": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Ratio of failed requests, between `0` and `1`.",
+			},
+			"average_duration_ms": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Average duration of the requests in milliseconds.",
+			},
+			"slowest_endpoints": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Endpoints with the longest requests, the slowest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"method": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "HTTP method of the requests, for example `GET`.",
+						},
+						"path": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Path of the requests, without query.",
+						},
+						"calls": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of requests sent to the endpoint.",
+						},
+						"retries": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of retries sent to the endpoint.",
+						},
+						"failures": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Number of failed requests to the endpoint.",
+						},
+						"max_duration_ms": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Duration of the longest request to the endpoint in milliseconds.",
+						},
+						"average_duration_ms": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Average duration of the requests to the endpoint in milliseconds.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApiStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *ApiStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApiStatsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	slowestCount := defaultSlowestEndpoints
+	if !data.SlowestCount.IsNull() {
+		slowestCount = int(data.SlowestCount.ValueInt64())
+	}
+	stats := d.client.Stats()
+	tflog.Debug(ctx, fmt.Sprintf("API stats :%+v", stats))
+	data.Since = types.StringValue(stats.Since.Format(time.RFC3339))
+	data.Calls = types.Int64Value(int64(stats.Calls))
+	data.Retries = types.Int64Value(int64(stats.Retries))
+	data.Failures = types.Int64Value(int64(stats.Failures))
+	data.FailureRate = types.Float64Value(0)
+	if stats.Calls > 0 {
+		data.FailureRate = types.Float64Value(float64(stats.Failures) / float64(stats.Calls))
+	}
+	data.AverageDurationMs = types.Int64Value(averageMs(stats.Total, stats.Calls))
+	data.SlowestEndpoints = []ApiEndpointModel{}
+	for _, endpoint := range stats.Slowest(slowestCount) {
+		data.SlowestEndpoints = append(data.SlowestEndpoints, ApiEndpointModel{
+			Method:            types.StringValue(endpoint.Method),
+			Path:              types.StringValue(endpoint.Path),
+			Calls:             types.Int64Value(int64(endpoint.Calls)),
+			Retries:           types.Int64Value(int64(endpoint.Retries)),
+			Failures:          types.Int64Value(int64(endpoint.Failures)),
+			MaxDurationMs:     types.Int64Value(endpoint.Max.Milliseconds()),
+			AverageDurationMs: types.Int64Value(averageMs(endpoint.Total, endpoint.Calls)),
+		})
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s-api-stats", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func averageMs(total time.Duration, calls int) int64 {
+	if calls == 0 {
+		return 0
+	}
+	return total.Milliseconds() / int64(calls)
+}
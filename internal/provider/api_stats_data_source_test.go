@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccApiStatsDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiStatsDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_api_stats.test", "calls"),
+					resource.TestCheckResourceAttrSet("data.f5os_api_stats.test", "since"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApiStatsDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiStatsDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// the logins of the provider are the only calls
+					resource.TestCheckResourceAttrWith("data.f5os_api_stats.test", "calls", func(value string) error {
+						if calls, _ := strconv.Atoi(value); calls == 0 {
+							return fmt.Errorf("expected the login calls to be counted, got %s", value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("data.f5os_api_stats.test", "slowest_endpoints.#", "1"),
+					resource.TestCheckResourceAttrSet("data.f5os_api_stats.test", "slowest_endpoints.0.path"),
+					resource.TestCheckResourceAttrSet("data.f5os_api_stats.test", "failure_rate"),
+				),
+			},
+		},
+	})
+}
+
+const testAccApiStatsDatasourceConfig = `
+data "f5os_api_stats" "test" {
+  slowest_count = 1
+}
+`
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+var (
+	auditLoggersMu sync.Mutex
+	auditLoggers   []*f5ossdk.AuditLogger
+)
+
+// CloseAuditLogs writes the summary metrics of the API audit logs of the provider instances
+// configured by the process and closes them, once Terraform no longer uses the provider.
+func CloseAuditLogs() {
+	auditLoggersMu.Lock()
+	defer auditLoggersMu.Unlock()
+	for _, auditLogger := range auditLoggers {
+		_ = auditLogger.WriteMetrics()
+		if closer, ok := auditLogger.Sink.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+	auditLoggers = nil
+}
+
+// newAuditLogger returns the logger appending the API calls of the provider to the file path, the
+// calls being attributed to the resources and data sources of the provider issuing them.
+func (p *F5osProvider) newAuditLogger(ctx context.Context, path string) (*f5ossdk.AuditLogger, error) {
+	sink, err := f5ossdk.NewAuditFileSink(path)
+	if err != nil {
+		return nil, err
+	}
+	auditLogger := f5ossdk.NewAuditLogger(sink)
+	auditLogger.Resource = auditResource(p.typeNames(ctx))
+	auditLoggersMu.Lock()
+	auditLoggers = append(auditLoggers, auditLogger)
+	auditLoggersMu.Unlock()
+	return auditLogger, nil
+}
+
+// typeNames maps the Go types of the resources and data sources of the provider to their
+// Terraform type names.
+func (p *F5osProvider) typeNames(ctx context.Context) map[string]string {
+	typeNames := make(map[string]string)
+	for _, newResource := range p.Resources(ctx) {
+		res := newResource()
+		metadata := &resource.MetadataResponse{}
+		res.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "f5os"}, metadata)
+		typeNames[reflect.TypeOf(res).Elem().Name()] = metadata.TypeName
+	}
+	for _, newDataSource := range p.DataSources(ctx) {
+		dataSource := newDataSource()
+		metadata := &datasource.MetadataResponse{}
+		dataSource.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "f5os"}, metadata)
+		typeNames[reflect.TypeOf(dataSource).Elem().Name()] = "data." + metadata.TypeName
+	}
+	return typeNames
+}
+
+// auditResource returns the function naming the resource or data source, and its operation, a
+// request is sent by, such as `f5os_vlan (create)`, from the methods of the call stack.
+func auditResource(typeNames map[string]string) func(frames *runtime.Frames) string {
+	return func(frames *runtime.Frames) string {
+		for {
+			frame, more := frames.Next()
+			// methods are named like <package>.(*VlanResource).Create
+			function, isMethod := strings.CutPrefix(frame.Function[strings.LastIndex(frame.Function, "/")+1:], "provider.(*")
+			if receiver, method, ok := strings.Cut(function, ")."); isMethod && ok {
+				method, _, _ = strings.Cut(method, ".")
+				if typeName, known := typeNames[receiver]; known {
+					return typeName + " (" + strings.ToLower(method) + ")"
+				}
+				if receiver == "F5osProvider" {
+					return "provider (" + strings.ToLower(method) + ")"
+				}
+			}
+			if !more {
+				return ""
+			}
+		}
+	}
+}
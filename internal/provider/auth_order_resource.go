@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AuthOrderResource{}
+var _ resource.ResourceWithImportState = &AuthOrderResource{}
+
+// authMethods maps the supported authentication methods to their YANG identity.
+var authMethods = map[string]string{
+	"radius": "openconfig-aaa-types:RADIUS_ALL",
+	"tacacs": "openconfig-aaa-types:TACACS_ALL",
+	"ldap":   "f5-openconfig-aaa-ldap:LDAP_ALL",
+	"local":  "openconfig-aaa-types:LOCAL",
+}
+
+func NewAuthOrderResource() resource.Resource {
+	return &AuthOrderResource{}
+}
+
+// AuthOrderResource defines the resource implementation.
+type AuthOrderResource struct {
+	client *f5ossdk.F5os
+}
+
+// AuthOrderResourceModel describes the resource data model.
+type AuthOrderResourceModel struct {
+	Methods types.List   `tfsdk:"methods"`
+	Id      types.String `tfsdk:"id"`
+}
+
+func (r *AuthOrderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_order"
+}
+
+func (r *AuthOrderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource used to manage the order of the methods users of F5OS systems are authenticated with.\n\n" +
+			"~> **NOTE** Users are only authenticated with the methods of the list, keep `local` in it to be able to log in when the remote servers are unreachable. " +
+			"The order is reset to `local` only when the resource is destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"methods": schema.ListAttribute{
+				MarkdownDescription: "Authentication methods, in the order they are tried: `tacacs`, `radius`, `ldap` and `local`.\nThe remote methods use the servers of all the `f5os_auth_server_group` of their type.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("tacacs", "radius", "ldap", "local")),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AuthOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *AuthOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AuthOrderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	methods := getAuthMethods(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] Authentication methods:%+v", methods))
+	_, err := r.client.SetAuthenticationMethods(methods)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Set Authentication Order failed, got error: %s", err))
+		return
+	}
+	data.Id = types.StringValue("auth-order")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuthOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AuthOrderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	methods, err := r.client.GetAuthenticationMethods()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Authentication Order, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Authentication methods :%+v", methods))
+	r.authOrderResourceModelToState(ctx, methods, data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuthOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *AuthOrderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	methods := getAuthMethods(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[UPDATE] Authentication methods:%+v", methods))
+	_, err := r.client.SetAuthenticationMethods(methods)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Set Authentication Order failed, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuthOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AuthOrderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "[DELETE] Resetting authentication methods to local")
+	_, err := r.client.SetAuthenticationMethods([]string{authMethods["local"]})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset Authentication Order, got error: %s", err))
+		return
+	}
+}
+
+func (r *AuthOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *AuthOrderResource) authOrderResourceModelToState(ctx context.Context, respData []string, data *AuthOrderResourceModel, diags *diag.Diagnostics) {
+	methods := []string{}
+	for _, identity := range respData {
+		for name, methodIdentity := range authMethods {
+			if identity == methodIdentity {
+				methods = append(methods, name)
+			}
+		}
+	}
+	var d diag.Diagnostics
+	data.Methods, d = types.ListValueFrom(ctx, types.StringType, methods)
+	diags.Append(d...)
+}
+
+func getAuthMethods(ctx context.Context, data *AuthOrderResourceModel, diags *diag.Diagnostics) []string {
+	var names []string
+	diags.Append(data.Methods.ElementsAs(ctx, &names, false)...)
+	var methods []string
+	for _, name := range names {
+		methods = append(methods, authMethods[name])
+	}
+	return methods
+}
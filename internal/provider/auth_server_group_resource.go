@@ -0,0 +1,390 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AuthServerGroupResource{}
+var _ resource.ResourceWithImportState = &AuthServerGroupResource{}
+var _ resource.ResourceWithValidateConfig = &AuthServerGroupResource{}
+
+var authServerType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"address": types.StringType,
+	"port":    types.Int64Type,
+	"secret":  types.StringType,
+	"ldaps":   types.BoolType,
+}}
+
+// authServerGroupTypes maps the supported server group types to their YANG identity.
+var authServerGroupTypes = map[string]string{
+	"radius": "openconfig-aaa:RADIUS",
+	"tacacs": "openconfig-aaa:TACACS",
+	"ldap":   "f5-openconfig-aaa-ldap:LDAP",
+}
+
+func NewAuthServerGroupResource() resource.Resource {
+	return &AuthServerGroupResource{}
+}
+
+// AuthServerGroupResource defines the resource implementation.
+type AuthServerGroupResource struct {
+	client *f5ossdk.F5os
+}
+
+// AuthServerGroupResourceModel describes the resource data model.
+type AuthServerGroupResourceModel struct {
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Servers types.List   `tfsdk:"servers"`
+	Id      types.String `tfsdk:"id"`
+}
+
+// AuthServerModel describes an entry of the servers list of a server group.
+type AuthServerModel struct {
+	Address types.String `tfsdk:"address"`
+	Port    types.Int64  `tfsdk:"port"`
+	Secret  types.String `tfsdk:"secret"`
+	Ldaps   types.Bool   `tfsdk:"ldaps"`
+}
+
+func (r *AuthServerGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_server_group"
+}
+
+func (r *AuthServerGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource used to manage the TACACS+, RADIUS and LDAP server groups users of F5OS systems are remotely authenticated with.\n\n" +
+			"The servers are only used once the group type is part of the `f5os_auth_order` methods.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the server group.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Authentication protocol of the servers of the group, `tacacs`, `radius` or `ldap`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("tacacs", "radius", "ldap"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"servers": schema.ListNestedAttribute{
+				MarkdownDescription: "Servers of the group.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							MarkdownDescription: "Hostname, IPv4 or IPv6 address of the server.",
+							Required:            true,
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "Port of the server, defaults to the port of the protocol on the device: `49` for TACACS+, `1812` for RADIUS and `389` for LDAP.",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"secret": schema.StringAttribute{
+							MarkdownDescription: "Secret shared with the TACACS+ or RADIUS server, required for these types.\nThe device does not return it, changes made outside of Terraform are not detected.",
+							Optional:            true,
+							Sensitive:           true,
+						},
+						"ldaps": schema.BoolAttribute{
+							MarkdownDescription: "Whether the LDAP server is reached over TLS, default is `false`. Only valid for the `ldap` type.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AuthServerGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *AuthServerGroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() || data.Servers.IsUnknown() {
+		return
+	}
+	var servers []AuthServerModel
+	resp.Diagnostics.Append(data.Servers.ElementsAs(ctx, &servers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	addresses := make(map[string]bool)
+	for _, server := range servers {
+		address := server.Address.ValueString()
+		if addresses[address] {
+			resp.Diagnostics.AddAttributeError(path.Root("servers"), "Invalid Server Group Servers", fmt.Sprintf("Server %s is configured more than once", address))
+		}
+		addresses[address] = true
+		if data.Type.ValueString() == "ldap" {
+			if !server.Secret.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("servers"), "Invalid Server Group Servers", fmt.Sprintf("`secret` of server %s is not supported with the `ldap` type", address))
+			}
+			continue
+		}
+		if server.Secret.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("servers"), "Invalid Server Group Servers", fmt.Sprintf("`secret` of server %s is required with the `%s` type", address, data.Type.ValueString()))
+		}
+		if server.Ldaps.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("servers"), "Invalid Server Group Servers", fmt.Sprintf("`ldaps` of server %s is only supported with the `ldap` type", address))
+		}
+	}
+}
+
+func (r *AuthServerGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *AuthServerGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *AuthServerGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] Server Group:%+v", data.Name.ValueString()))
+
+	groupConfig := getAuthServerGroupConfig(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, err := r.client.AuthServerGroupConfig(groupConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Create Server Group failed, got error: %s", err))
+		return
+	}
+	data.Id = types.StringValue(data.Name.ValueString())
+
+	groupData, err := r.client.GetAuthServerGroup(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Server Group, got error: %s", err))
+		return
+	}
+	r.authServerGroupResourceModelToState(ctx, groupData, data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuthServerGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *AuthServerGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[READ] Server Group:%+v", data.Id.ValueString()))
+
+	exists, err := r.client.AuthServerGroupExists(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Server Group, got error: %s", err))
+		return
+	}
+	if !exists {
+		tflog.Warn(ctx, fmt.Sprintf("Server Group %s no longer on the system, removing it from state", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	groupData, err := r.client.GetAuthServerGroup(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Server Group, got error: %s", err))
+		return
+	}
+	r.authServerGroupResourceModelToState(ctx, groupData, data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuthServerGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *AuthServerGroupResourceModel
+	var state *AuthServerGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[UPDATE] Server Group:%+v", data.Name.ValueString()))
+
+	var servers, haveServers []AuthServerModel
+	resp.Diagnostics.Append(data.Servers.ElementsAs(ctx, &servers, false)...)
+	resp.Diagnostics.Append(state.Servers.ElementsAs(ctx, &haveServers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, address := range stringListDifference(authServerAddresses(haveServers), authServerAddresses(servers)) {
+		if err := r.client.RemoveAuthServer(data.Name.ValueString(), address); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove server %s from Server Group, got error: %s", address, err))
+			return
+		}
+	}
+	groupConfig := getAuthServerGroupConfig(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, err := r.client.AuthServerGroupConfig(groupConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Update Server Group failed, got error: %s", err))
+		return
+	}
+	groupData, err := r.client.GetAuthServerGroup(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Server Group, got error: %s", err))
+		return
+	}
+	r.authServerGroupResourceModelToState(ctx, groupData, data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuthServerGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *AuthServerGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.client.DeleteAuthServerGroup(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to Delete Server Group, got error: %s", err))
+		return
+	}
+}
+
+func (r *AuthServerGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// authServerGroupResourceModelToState sets the group from the device, keeping the secrets of
+// the servers from data since the device does not return them.
+func (r *AuthServerGroupResource) authServerGroupResourceModelToState(ctx context.Context, respData *f5ossdk.F5RespAuthServerGroup, data *AuthServerGroupResourceModel, diags *diag.Diagnostics) {
+	tflog.Debug(ctx, fmt.Sprintf("ServerGroupResp :%+v", respData))
+	if len(respData.ServerGroup) == 0 {
+		return
+	}
+	group := respData.ServerGroup[0]
+	data.Name = types.StringValue(group.Name)
+	for name, identity := range authServerGroupTypes {
+		if identity == group.Config.Type || strings.HasSuffix(group.Config.Type, ":"+strings.ToUpper(name)) {
+			data.Type = types.StringValue(name)
+		}
+	}
+	secrets := make(map[string]types.String)
+	if !data.Servers.IsNull() && !data.Servers.IsUnknown() {
+		var haveServers []AuthServerModel
+		diags.Append(data.Servers.ElementsAs(ctx, &haveServers, false)...)
+		for _, server := range haveServers {
+			secrets[server.Address.ValueString()] = server.Secret
+		}
+	}
+	servers := []AuthServerModel{}
+	for _, server := range group.Servers.Server {
+		serverModel := AuthServerModel{
+			Address: types.StringValue(server.Address),
+			Port:    types.Int64Null(),
+			Secret:  types.StringNull(),
+			Ldaps:   types.BoolValue(false),
+		}
+		if secret, ok := secrets[server.Address]; ok {
+			serverModel.Secret = secret
+		}
+		switch {
+		case server.Radius != nil:
+			serverModel.Port = types.Int64Value(int64(server.Radius.Config.AuthPort))
+		case server.Tacacs != nil:
+			serverModel.Port = types.Int64Value(int64(server.Tacacs.Config.Port))
+		case server.Ldap != nil:
+			serverModel.Port = types.Int64Value(int64(server.Ldap.Config.Port))
+			serverModel.Ldaps = types.BoolValue(strings.HasSuffix(server.Ldap.Config.Type, "LDAPS"))
+		}
+		servers = append(servers, serverModel)
+	}
+	var d diag.Diagnostics
+	data.Servers, d = types.ListValueFrom(ctx, authServerType, servers)
+	diags.Append(d...)
+}
+
+func getAuthServerGroupConfig(ctx context.Context, data *AuthServerGroupResourceModel, diags *diag.Diagnostics) *f5ossdk.F5ReqAuthServerGroups {
+	var servers []AuthServerModel
+	diags.Append(data.Servers.ElementsAs(ctx, &servers, false)...)
+	group := f5ossdk.F5AuthServerGroup{Name: data.Name.ValueString()}
+	group.Config.Name = data.Name.ValueString()
+	group.Config.Type = authServerGroupTypes[data.Type.ValueString()]
+	for _, server := range servers {
+		serverConfig := f5ossdk.F5AuthServer{Address: server.Address.ValueString()}
+		serverConfig.Config.Address = server.Address.ValueString()
+		port := 0
+		if !server.Port.IsNull() && !server.Port.IsUnknown() {
+			port = int(server.Port.ValueInt64())
+		}
+		switch data.Type.ValueString() {
+		case "radius":
+			serverConfig.Radius = &f5ossdk.F5AuthRadius{Config: f5ossdk.F5AuthRadiusConfig{AuthPort: port, SecretKey: server.Secret.ValueString()}}
+		case "tacacs":
+			serverConfig.Tacacs = &f5ossdk.F5AuthTacacs{Config: f5ossdk.F5AuthTacacsConfig{Port: port, SecretKey: server.Secret.ValueString()}}
+		case "ldap":
+			ldapType := "f5-openconfig-aaa-ldap:LDAP"
+			if server.Ldaps.ValueBool() {
+				ldapType = "f5-openconfig-aaa-ldap:LDAPS"
+			}
+			serverConfig.Ldap = &f5ossdk.F5AuthLdap{Config: f5ossdk.F5AuthLdapConfig{Port: port, Type: ldapType}}
+		}
+		group.Servers.Server = append(group.Servers.Server, serverConfig)
+	}
+	groupConfig := &f5ossdk.F5ReqAuthServerGroups{}
+	groupConfig.ServerGroups.ServerGroup = append(groupConfig.ServerGroups.ServerGroup, group)
+	return groupConfig
+}
+
+func authServerAddresses(servers []AuthServerModel) []string {
+	var addresses []string
+	for _, server := range servers {
+		addresses = append(addresses, server.Address.ValueString())
+	}
+	return addresses
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &AvailableUpgradesDataSource{}
+)
+
+func NewAvailableUpgradesDataSource() datasource.DataSource {
+	return &AvailableUpgradesDataSource{}
+}
+
+// AvailableUpgradesDataSource defines the data source implementation.
+type AvailableUpgradesDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// AvailableUpgradesDataSourceModel describes the data source data model.
+type AvailableUpgradesDataSourceModel struct {
+	ID                types.String       `tfsdk:"id"`
+	RunningVersion    types.String       `tfsdk:"running_version"`
+	UpgradeCandidates []types.String     `tfsdk:"upgrade_candidates"`
+	LatestVersion     types.String       `tfsdk:"latest_version"`
+	Images            []StagedImageModel `tfsdk:"images"`
+}
+
+type StagedImageModel struct {
+	Version          types.String `tfsdk:"version"`
+	Status           types.String `tfsdk:"status"`
+	Date             types.String `tfsdk:"date"`
+	UpgradeCandidate types.Bool   `tfsdk:"upgrade_candidate"`
+}
+
+func (d *AvailableUpgradesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_available_upgrades"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *AvailableUpgradesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the system ISO images staged on rSeries appliances or VELOS controllers and which of them are upgrades of the running version.\n\n" +
+			"Use this data source to only run an upgrade when a newer version is staged, an image is a candidate when it is `ready` and newer than the running version.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"running_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Version the system runs, for example `1.7.0-3518`.\nOn VELOS controllers running different versions, the lowest of them.",
+			},
+			"upgrade_candidates": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Versions of the staged images that are upgrade candidates, from the oldest to the newest.",
+			},
+			"latest_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Newest of the `upgrade_candidates`, empty when no upgrade is staged.",
+			},
+			"images": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of the staged ISO images, from the oldest to the newest version.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Version of the image.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Status of the image, for example `ready` or `verification-failed`.",
+						},
+						"date": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Build date of the image, empty when not reported.",
+						},
+						"upgrade_candidate": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the image is `ready` and newer than the running version.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AvailableUpgradesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *AvailableUpgradesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AvailableUpgradesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client.PlatformType == "Velos Partition" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_available_upgrades` data source is supported with Velos Controller level/rSeries appliance.")
+		return
+	}
+	runningVersion, images, err := d.client.GetSystemImages()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Get System Images", fmt.Sprintf("Error:%s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Running version :%+v System Images :%+v", runningVersion, images))
+	sort.SliceStable(images, func(i, j int) bool {
+		return f5ossdk.CompareVersions(images[i].Version, images[j].Version) < 0
+	})
+	data.RunningVersion = types.StringValue(runningVersion)
+	data.LatestVersion = types.StringValue("")
+	data.UpgradeCandidates = []types.String{}
+	data.Images = []StagedImageModel{}
+	for _, image := range images {
+		candidate := image.Status == "ready" && f5ossdk.CompareVersions(image.Version, runningVersion) > 0
+		if candidate {
+			data.UpgradeCandidates = append(data.UpgradeCandidates, types.StringValue(image.Version))
+			data.LatestVersion = types.StringValue(image.Version)
+		}
+		data.Images = append(data.Images, StagedImageModel{
+			Version:          types.StringValue(image.Version),
+			Status:           types.StringValue(image.Status),
+			Date:             types.StringValue(image.Date),
+			UpgradeCandidate: types.BoolValue(candidate),
+		})
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s-available-upgrades", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
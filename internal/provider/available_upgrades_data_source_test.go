@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAvailableUpgradesDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAvailableUpgradesDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_available_upgrades.test", "running_version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAvailableUpgradesDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/rseries_platform_state_ok.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-image:image/state/install", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/rseries_platform_version.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-image:image", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/rseries_system_images.json"))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAvailableUpgradesDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "running_version", "1.7.0-3518"),
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "images.#", "5"),
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "images.0.version", "1.5.1-12283"),
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "images.0.upgrade_candidate", "false"),
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "images.2.version", "1.7.1-4600"),
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "images.2.upgrade_candidate", "false"),
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "images.4.date", "2024-04-04"),
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "upgrade_candidates.#", "2"),
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "upgrade_candidates.0", "1.7.10-250"),
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "upgrade_candidates.1", "1.8.0-13497"),
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "latest_version", "1.8.0-13497"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAvailableUpgradesDataSourceUnitTC2(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/velos_controller_components.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-controller-image:image", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/velos_controller_staged_images.json"))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAvailableUpgradesDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "running_version", "1.5.1-5968"),
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "images.#", "2"),
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "images.1.version", "1.6.0-9817"),
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "images.1.upgrade_candidate", "true"),
+					resource.TestCheckResourceAttr("data.f5os_available_upgrades.test", "latest_version", "1.6.0-9817"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAvailableUpgradesDatasourceConfig = `
+data "f5os_available_upgrades" "test" {}
+`
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &BladeSoftwareVersionsDataSource{}
+)
+
+func NewBladeSoftwareVersionsDataSource() datasource.DataSource {
+	return &BladeSoftwareVersionsDataSource{}
+}
+
+// BladeSoftwareVersionsDataSource defines the data source implementation.
+type BladeSoftwareVersionsDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// BladeSoftwareVersionsDataSourceModel describes the data source data model.
+type BladeSoftwareVersionsDataSourceModel struct {
+	ID              types.String                `tfsdk:"id"`
+	Partition       types.String                `tfsdk:"partition"`
+	ExpectedVersion types.String                `tfsdk:"expected_version"`
+	Blades          []BladeSoftwareVersionModel `tfsdk:"blades"`
+	Stragglers      []types.String              `tfsdk:"stragglers"`
+}
+
+type BladeSoftwareVersionModel struct {
+	Name             types.String `tfsdk:"name"`
+	SlotNum          types.Int64  `tfsdk:"slot_num"`
+	Partition        types.String `tfsdk:"partition"`
+	OsVersion        types.String `tfsdk:"os_version"`
+	SoftwareVersions types.Map    `tfsdk:"software_versions"`
+	Converged        types.Bool   `tfsdk:"converged"`
+}
+
+func (d *BladeSoftwareVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blade_software_versions"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *BladeSoftwareVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the software versions actually running on each blade of a VELOS chassis.\n\n" +
+			"Use this data source at the Velos Controller level after an upgrade to find blades that failed to converge to the partition version.",
+
+		Attributes: map[string]schema.Attribute{
+			"partition": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only report blades assigned to this partition.",
+			},
+			"expected_version": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Version every software component of the blades is expected to run.\nWhen not set, each component is compared with the version most of the reported blades run.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"stragglers": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the blades that are not running the expected versions.",
+			},
+			"blades": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of blades reporting software versions, ordered by slot number.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the blade, for example `blade-1`.",
+						},
+						"slot_num": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Slot number the blade is inserted into.",
+						},
+						"partition": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Partition the slot is assigned to.",
+						},
+						"os_version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Blade OS version running on the blade.",
+						},
+						"software_versions": schema.MapAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Running version of every software component of the blade, keyed by software index.",
+						},
+						"converged": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether all software components of the blade run the expected version.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BladeSoftwareVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *BladeSoftwareVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BladeSoftwareVersionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client.PlatformType != "Velos Controller" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_blade_software_versions` data source is supported with Velos Controller level.")
+		return
+	}
+	blades, err := d.client.GetBlades()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Get Blade Details", fmt.Sprintf("Error:%s", err))
+		return
+	}
+	var reported []f5ossdk.F5Blade
+	for _, blade := range blades {
+		if len(blade.SoftwareVersions) == 0 {
+			continue
+		}
+		if !data.Partition.IsNull() && blade.Partition != data.Partition.ValueString() {
+			continue
+		}
+		reported = append(reported, blade)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Blade software versions :%+v", reported))
+	expected := expectedBladeVersions(reported, data.ExpectedVersion.ValueString())
+
+	data.Blades = []BladeSoftwareVersionModel{}
+	data.Stragglers = []types.String{}
+	for _, blade := range reported {
+		converged := true
+		for index, version := range blade.SoftwareVersions {
+			if version != expected[index] {
+				converged = false
+			}
+		}
+		if !converged {
+			data.Stragglers = append(data.Stragglers, types.StringValue(blade.Name))
+		}
+		versions, diags := types.MapValueFrom(ctx, types.StringType, blade.SoftwareVersions)
+		resp.Diagnostics.Append(diags...)
+		data.Blades = append(data.Blades, BladeSoftwareVersionModel{
+			Name:             types.StringValue(blade.Name),
+			SlotNum:          types.Int64Value(int64(blade.SlotNum)),
+			Partition:        types.StringValue(blade.Partition),
+			OsVersion:        types.StringValue(blade.RunningVersion),
+			SoftwareVersions: versions,
+			Converged:        types.BoolValue(converged),
+		})
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s-blade-software-versions", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// expectedBladeVersions returns the version every software index is expected to run, either the
+// configured version or the one most blades report (highest version wins a tie).
+func expectedBladeVersions(blades []f5ossdk.F5Blade, expectedVersion string) map[string]string {
+	counts := make(map[string]map[string]int)
+	for _, blade := range blades {
+		for index, version := range blade.SoftwareVersions {
+			if counts[index] == nil {
+				counts[index] = make(map[string]int)
+			}
+			counts[index][version]++
+		}
+	}
+	expected := make(map[string]string)
+	for index, versions := range counts {
+		if expectedVersion != "" {
+			expected[index] = expectedVersion
+			continue
+		}
+		var candidates []string
+		for version := range versions {
+			candidates = append(candidates, version)
+		}
+		sort.Slice(candidates, func(i, j int) bool {
+			if versions[candidates[i]] != versions[candidates[j]] {
+				return versions[candidates[i]] > versions[candidates[j]]
+			}
+			return candidates[i] > candidates[j]
+		})
+		expected[index] = candidates[0]
+	}
+	return expected
+}
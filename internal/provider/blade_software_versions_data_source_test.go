@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBladeSoftwareVersionsDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBladeSoftwareVersionsDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_blade_software_versions.test", "blades.0.os_version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBladeSoftwareVersionsDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/velos_controller_blade_versions.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-controller-image:image", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/velos_controller_image.json"))
+	})
+	mux.HandleFunc("/restconf/data/f5-system-slot:slots/slot", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/partition_get_slots.json"))
+	})
+	mux.HandleFunc("/restconf/data/f5-cluster:cluster/nodes/node", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/velos_cluster_nodes.json"))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBladeSoftwareVersionsDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_blade_software_versions.test", "blades.#", "2"),
+					resource.TestCheckResourceAttr("data.f5os_blade_software_versions.test", "blades.0.name", "blade-1"),
+					resource.TestCheckResourceAttr("data.f5os_blade_software_versions.test", "blades.0.os_version", "1.6.0-9817"),
+					resource.TestCheckResourceAttr("data.f5os_blade_software_versions.test", "blades.0.software_versions.blade-service", "1.6.0-9817"),
+					resource.TestCheckResourceAttr("data.f5os_blade_software_versions.test", "blades.0.converged", "true"),
+					resource.TestCheckResourceAttr("data.f5os_blade_software_versions.test", "blades.1.os_version", "1.5.1-5968"),
+					resource.TestCheckResourceAttr("data.f5os_blade_software_versions.test", "blades.1.converged", "false"),
+					resource.TestCheckResourceAttr("data.f5os_blade_software_versions.test", "stragglers.#", "1"),
+					resource.TestCheckResourceAttr("data.f5os_blade_software_versions.test", "stragglers.0", "blade-2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccBladeSoftwareVersionsDatasourceConfig = `
+data "f5os_blade_software_versions" "test" {
+  partition        = "TerraformPartition"
+  expected_version = "1.6.0-9817"
+}
+`
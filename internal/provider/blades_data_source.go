@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &BladesDataSource{}
+)
+
+func NewBladesDataSource() datasource.DataSource {
+	return &BladesDataSource{}
+}
+
+// BladesDataSource defines the data source implementation.
+type BladesDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// BladesDataSourceModel describes the data source data model.
+type BladesDataSourceModel struct {
+	ID     types.String      `tfsdk:"id"`
+	Blades []BladeStateModel `tfsdk:"blades"`
+}
+
+type BladeStateModel struct {
+	Name             types.String `tfsdk:"name"`
+	SlotNum          types.Int64  `tfsdk:"slot_num"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	Partition        types.String `tfsdk:"partition"`
+	PowerState       types.String `tfsdk:"power_state"`
+	OperStatus       types.String `tfsdk:"oper_status"`
+	NodeRunningState types.String `tfsdk:"node_running_state"`
+	RunningVersion   types.String `tfsdk:"running_version"`
+}
+
+func (d *BladesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blades"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *BladesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get information about the blades of a VELOS chassis.\n\n" +
+			"Use this data source at the Velos Controller level to get the power state, assigned partition, running version and health of every blade slot.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"blades": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of blades, ordered by slot number.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the blade, for example `blade-1`.",
+						},
+						"slot_num": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Slot number the blade is inserted into.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the slot is enabled.",
+						},
+						"partition": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Partition the slot is assigned to, `none` when unassigned.",
+						},
+						"power_state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Power state of the blade.",
+						},
+						"oper_status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Operational (health) status of the blade.",
+						},
+						"node_running_state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Running state of the cluster node hosted on the blade.",
+						},
+						"running_version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Blade OS version running on the blade.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BladesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *BladesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BladesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client.PlatformType != "Velos Controller" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_blades` data source is supported with Velos Controller level.")
+		return
+	}
+	blades, err := d.client.GetBlades()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Get Blade Details", fmt.Sprintf("Error:%s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Blades :%+v", blades))
+	data.Blades = []BladeStateModel{}
+	for _, blade := range blades {
+		data.Blades = append(data.Blades, BladeStateModel{
+			Name:             types.StringValue(blade.Name),
+			SlotNum:          types.Int64Value(int64(blade.SlotNum)),
+			Enabled:          types.BoolValue(blade.Enabled),
+			Partition:        types.StringValue(blade.Partition),
+			PowerState:       types.StringValue(blade.PowerState),
+			OperStatus:       types.StringValue(blade.OperStatus),
+			NodeRunningState: types.StringValue(blade.NodeRunningState),
+			RunningVersion:   types.StringValue(blade.RunningVersion),
+		})
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s-blades", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,413 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BootstrapResource{}
+var _ resource.ResourceWithImportState = &BootstrapResource{}
+
+// bootstrapImportUnsupported tells why f5os_bootstrap cannot be imported.
+const bootstrapImportUnsupported = "it runs the first-boot setup once and does not read the settings back"
+
+const defaultPrimaryKeyTimeout = 300
+
+func NewBootstrapResource() resource.Resource {
+	return &BootstrapResource{}
+}
+
+// BootstrapResource defines the resource implementation.
+type BootstrapResource struct {
+	client *f5ossdk.F5os
+}
+
+// BootstrapResourceModel describes the resource data model.
+type BootstrapResourceModel struct {
+	UserName          types.String              `tfsdk:"user_name"`
+	OldPassword       types.String              `tfsdk:"old_password"`
+	NewPassword       types.String              `tfsdk:"new_password"`
+	PrimaryKey        *BootstrapPrimaryKeyModel `tfsdk:"primary_key"`
+	PrimaryKeyTimeout types.Int64               `tfsdk:"primary_key_timeout"`
+	Hostname          types.String              `tfsdk:"hostname"`
+	DnsServers        types.List                `tfsdk:"dns_servers"`
+	DnsSearchDomains  types.List                `tfsdk:"dns_search_domains"`
+	NtpServers        types.List                `tfsdk:"ntp_servers"`
+	AllowedIps        types.List                `tfsdk:"allowed_ips"`
+	AllowLockout      types.Bool                `tfsdk:"allow_lockout"`
+	Id                types.String              `tfsdk:"id"`
+}
+
+// BootstrapPrimaryKeyModel describes the primary key set by the bootstrap.
+type BootstrapPrimaryKeyModel struct {
+	Passphrase types.String `tfsdk:"passphrase"`
+	Salt       types.String `tfsdk:"salt"`
+}
+
+func (r *BootstrapResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bootstrap"
+}
+
+func (r *BootstrapResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource used to run the first-boot setup of F5OS systems in the documented order: admin password change, primary key, hostname, DNS, NTP and allowed IPs.\n\n" +
+			"Every step is optional and skipped when its attributes are not set. The other resources of a zero-touch provisioning module only need to depend on this resource to run once the system is set up. " +
+			"Updates run again the steps whose attributes changed, in the same order, and remove the DNS and NTP servers, search domains and allowed IPs dropped from the lists.\n\n" +
+			"~> **NOTE** The resource does not read the settings back, use the `f5os_dns`, `f5os_ntp` and `f5os_allowed_ips` resources to manage them after the setup. " +
+			"Destroying the resource only removes it from the Terraform state, the settings stay on the system.\n\n" +
+			"The resource cannot be imported, " + bootstrapImportUnsupported + ".",
+
+		Attributes: map[string]schema.Attribute{
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the user whose password `new_password` sets, default is `admin`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("admin"),
+			},
+			"old_password": schema.StringAttribute{
+				MarkdownDescription: "Current password of the user, such as the default password to change at first login.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("new_password")),
+				},
+			},
+			"new_password": schema.StringAttribute{
+				MarkdownDescription: "New password of the user. When the user is the one the provider logs in with, the provider logs in again with the new password, which the provider configuration has to use from then on. " +
+					"Changing it later changes the password from the previous `new_password`.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("old_password")),
+				},
+			},
+			"primary_key": schema.SingleNestedAttribute{
+				MarkdownDescription: "Primary key encrypting the secrets of the system configuration and its backups, set before any other secret is configured.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"passphrase": schema.StringAttribute{
+						MarkdownDescription: "Passphrase the primary key is derived from.",
+						Required:            true,
+						Sensitive:           true,
+					},
+					"salt": schema.StringAttribute{
+						MarkdownDescription: "Salt the primary key is derived with.",
+						Required:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"primary_key_timeout": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Time in seconds to wait for the system to re-encrypt its secrets with the primary key, default is `%d`.", defaultPrimaryKeyTimeout),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultPrimaryKeyTimeout),
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the system.",
+				Optional:            true,
+			},
+			"dns_servers": schema.ListAttribute{
+				MarkdownDescription: "IPv4 or IPv6 addresses of the DNS servers, in the order they are queried.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"dns_search_domains": schema.ListAttribute{
+				MarkdownDescription: "Domains appended to names that are not fully qualified when they are resolved.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"ntp_servers": schema.ListAttribute{
+				MarkdownDescription: "Addresses or hostnames of the NTP servers, NTP being enabled when it is set.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"allowed_ips": schema.ListNestedAttribute{
+				MarkdownDescription: "Entries of the allow-list of the addresses the management interfaces can be reached from, applied last. " +
+					"As with `f5os_allowed_ips`, a list that does not allow the address the provider connects from is refused unless `allow_lockout` is set.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the entry.",
+							Required:            true,
+						},
+						"prefix": schema.StringAttribute{
+							MarkdownDescription: "IPv4 or IPv6 address or CIDR prefix allowed, for example `192.0.2.0/24`.",
+							Required:            true,
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "Port the prefix is allowed to reach, all ports when not set.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"allow_lockout": schema.BoolAttribute{
+				MarkdownDescription: "Whether `allowed_ips` is applied when it does not allow the address the provider connects from, default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BootstrapResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *BootstrapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *BootstrapResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.bootstrap(ctx, data, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s-bootstrap", r.client.Host))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BootstrapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *BootstrapResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BootstrapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *BootstrapResourceModel
+	var state *BootstrapResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.bootstrap(ctx, data, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BootstrapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *BootstrapResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "[DELETE] Bootstrap removed from the state, the settings stay on the system")
+}
+
+func (r *BootstrapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importNotSupported(resp, "f5os_bootstrap", bootstrapImportUnsupported)
+}
+
+// bootstrap runs the setup steps of data that differ from state, every step when state is nil.
+// The password is changed first, since the system refuses any other change until the default
+// password is changed, and the allow-list is applied last, once nothing else needs the current
+// address of the provider.
+func (r *BootstrapResource) bootstrap(ctx context.Context, data, state *BootstrapResourceModel, diags *diag.Diagnostics) {
+	if state == nil {
+		state = &BootstrapResourceModel{
+			DnsServers:       types.ListNull(types.StringType),
+			DnsSearchDomains: types.ListNull(types.StringType),
+			NtpServers:       types.ListNull(types.StringType),
+			AllowedIps:       types.ListNull(allowedIpType),
+		}
+	}
+	if !data.NewPassword.IsNull() && !data.NewPassword.Equal(state.NewPassword) {
+		oldPassword := data.OldPassword.ValueString()
+		if !state.NewPassword.IsNull() {
+			oldPassword = state.NewPassword.ValueString()
+		}
+		tflog.Info(ctx, fmt.Sprintf("[BOOTSTRAP] Changing the password of user %s", data.UserName.ValueString()))
+		if err := r.client.ChangeUserPassword(data.UserName.ValueString(), oldPassword, data.NewPassword.ValueString()); err != nil {
+			diags.AddError("F5OS Client Error", fmt.Sprintf("Unable to change the password of user %s, got error: %s", data.UserName.ValueString(), err))
+			return
+		}
+	}
+	if data.PrimaryKey != nil && (state.PrimaryKey == nil || *data.PrimaryKey != *state.PrimaryKey) {
+		tflog.Info(ctx, "[BOOTSTRAP] Setting the primary key")
+		timeout := time.Duration(data.PrimaryKeyTimeout.ValueInt64()) * time.Second
+		if err := r.client.SetPrimaryKey(data.PrimaryKey.Passphrase.ValueString(), data.PrimaryKey.Salt.ValueString(), timeout); err != nil {
+			diags.AddError("F5OS Client Error", fmt.Sprintf("Unable to set the primary key, got error: %s", err))
+			return
+		}
+	}
+	if !data.Hostname.IsNull() && !data.Hostname.Equal(state.Hostname) {
+		tflog.Info(ctx, fmt.Sprintf("[BOOTSTRAP] Setting hostname %s", data.Hostname.ValueString()))
+		if err := r.client.SetHostname(data.Hostname.ValueString()); err != nil {
+			diags.AddError("F5OS Client Error", fmt.Sprintf("Unable to set the hostname, got error: %s", err))
+			return
+		}
+	}
+	if !r.bootstrapDns(ctx, data, state, diags) {
+		return
+	}
+	if !r.bootstrapNtp(ctx, data, state, diags) {
+		return
+	}
+	r.bootstrapAllowedIps(ctx, data, state, diags)
+}
+
+func (r *BootstrapResource) bootstrapDns(ctx context.Context, data, state *BootstrapResourceModel, diags *diag.Diagnostics) bool {
+	if data.DnsServers.Equal(state.DnsServers) && data.DnsSearchDomains.Equal(state.DnsSearchDomains) {
+		return true
+	}
+	servers := bootstrapStrings(ctx, data.DnsServers, diags)
+	searchDomains := bootstrapStrings(ctx, data.DnsSearchDomains, diags)
+	haveServers := bootstrapStrings(ctx, state.DnsServers, diags)
+	haveSearchDomains := bootstrapStrings(ctx, state.DnsSearchDomains, diags)
+	if diags.HasError() {
+		return false
+	}
+	if len(servers) > 0 || len(searchDomains) > 0 {
+		dnsConfig := dnsEntriesConfig(servers, searchDomains)
+		tflog.Info(ctx, fmt.Sprintf("[BOOTSTRAP] DNS config :%+v", dnsConfig))
+		if err := r.client.SetDns(dnsConfig); err != nil {
+			diags.AddError("F5OS Client Error", fmt.Sprintf("Unable to configure DNS, got error: %s", err))
+			return false
+		}
+	}
+	for _, address := range stringListDifference(haveServers, servers) {
+		if err := r.client.RemoveDnsServer(address); err != nil && !isNotFound(err) {
+			diags.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove DNS server %s, got error: %s", address, err))
+			return false
+		}
+	}
+	for _, domain := range stringListDifference(haveSearchDomains, searchDomains) {
+		if err := r.client.RemoveDnsSearchDomain(domain); err != nil && !isNotFound(err) {
+			diags.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove DNS search domain %s, got error: %s", domain, err))
+			return false
+		}
+	}
+	return true
+}
+
+func (r *BootstrapResource) bootstrapNtp(ctx context.Context, data, state *BootstrapResourceModel, diags *diag.Diagnostics) bool {
+	if data.NtpServers.Equal(state.NtpServers) {
+		return true
+	}
+	servers := bootstrapStrings(ctx, data.NtpServers, diags)
+	haveServers := bootstrapStrings(ctx, state.NtpServers, diags)
+	if diags.HasError() {
+		return false
+	}
+	if len(servers) > 0 {
+		var ntpServers []NtpServerModel
+		for _, address := range servers {
+			ntpServers = append(ntpServers, NtpServerModel{Address: types.StringValue(address)})
+		}
+		ntpConfig := ntpRequest(&f5ossdk.F5NtpConfig{Enabled: true}, ntpServers, nil)
+		tflog.Info(ctx, fmt.Sprintf("[BOOTSTRAP] NTP config :%+v", ntpConfig))
+		if err := r.client.SetNtp(ntpConfig); err != nil {
+			diags.AddError("F5OS Client Error", fmt.Sprintf("Unable to configure NTP, got error: %s", err))
+			return false
+		}
+	}
+	for _, address := range stringListDifference(haveServers, servers) {
+		if err := r.client.RemoveNtpServer(address); err != nil && !isNotFound(err) {
+			diags.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove NTP server %s, got error: %s", address, err))
+			return false
+		}
+	}
+	return true
+}
+
+func (r *BootstrapResource) bootstrapAllowedIps(ctx context.Context, data, state *BootstrapResourceModel, diags *diag.Diagnostics) {
+	if data.AllowedIps.Equal(state.AllowedIps) || (data.AllowedIps.IsNull() && state.AllowedIps.IsNull()) {
+		return
+	}
+	allowedConfig := getAllowedIpsConfig(ctx, &AllowedIpsResourceModel{Allowed: data.AllowedIps}, diags)
+	var haveAllowed []AllowedIpModel
+	if !state.AllowedIps.IsNull() {
+		diags.Append(state.AllowedIps.ElementsAs(ctx, &haveAllowed, false)...)
+	}
+	if diags.HasError() {
+		return
+	}
+	removed := stringListDifference(allowedIpNames(haveAllowed), allowedConfigNames(allowedConfig))
+	tflog.Info(ctx, fmt.Sprintf("[BOOTSTRAP] Allowed IPs:%+v, removing:%+v", allowedConfig.AllowedIPs.Allowed, removed))
+	if !data.AllowLockout.ValueBool() {
+		allowedIps := &AllowedIpsResource{client: r.client}
+		allowedIps.checkLockout(allowedConfig, removed, diags)
+		if diags.HasError() {
+			return
+		}
+	}
+	if len(allowedConfig.AllowedIPs.Allowed) > 0 {
+		if _, err := r.client.AllowedIPsConfig(allowedConfig); err != nil {
+			diags.AddError("F5OS Client Error", fmt.Sprintf("Unable to configure Allowed IPs, got error: %s", err))
+			return
+		}
+	}
+	for _, name := range removed {
+		if err := r.client.DeleteAllowedIP(name); err != nil && !isNotFound(err) {
+			diags.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove Allowed IP %s, got error: %s", name, err))
+			return
+		}
+	}
+}
+
+// bootstrapStrings returns the elements of list, none when it is null.
+func bootstrapStrings(ctx context.Context, list types.List, diags *diag.Diagnostics) []string {
+	var values []string
+	if list.IsNull() || list.IsUnknown() {
+		return values
+	}
+	diags.Append(list.ElementsAs(ctx, &values, false)...)
+	return values
+}
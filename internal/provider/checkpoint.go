@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// checkpointState is the client of the provider, whose checkpoint config backup is reported once
+// when a change of a resource fails.
+type checkpointState struct {
+	client   atomic.Pointer[f5ossdk.F5os]
+	reported atomic.Bool
+}
+
+// checkpointRollback returns, on the first failed change of the apply, the warning naming the
+// checkpoint config backup created before the device was changed and how to restore it.
+func (p *F5osProvider) checkpointRollback() []*tfprotov6.Diagnostic {
+	client := p.checkpoint.client.Load()
+	if client == nil {
+		return nil
+	}
+	checkpoint, ok := client.Checkpoint()
+	if !ok || !p.checkpoint.reported.CompareAndSwap(false, true) {
+		return nil
+	}
+	return []*tfprotov6.Diagnostic{{
+		Severity: tfprotov6.DiagnosticSeverityWarning,
+		Summary:  "F5OS Configuration Checkpoint",
+		Detail: fmt.Sprintf("The apply failed after changing the device at %s. Its configuration was saved in the config backup %q at %s, before the first change.\n\n"+
+			"To roll the device back, declare the restore resource:\n\n"+
+			"  variable \"rollback\" {\n    default = false\n  }\n\n"+
+			"  resource \"f5os_config_restore\" \"rollback\" {\n    count = var.rollback ? 1 : 0\n    name  = %q\n  }\n\n"+
+			"and run: terraform apply -var rollback=true -target=f5os_config_restore.rollback\n\n"+
+			"Change checkpoint_backup before the next apply to keep this backup, the next run removes it once its own backup is created.",
+			client.Host, checkpoint.Name, checkpoint.Created.Format(time.RFC3339), checkpoint.Name),
+	}}
+}
@@ -1,6 +1,36 @@
 package provider
 
-import "net"
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// isNotFound reports whether err tells that the object is gone from the device, in which case
+// Read removes the resource from the state instead of failing the run.
+func isNotFound(err error) bool {
+	var notFound *f5ossdk.NotFoundError
+	return errors.As(err, &notFound)
+}
+
+// importNotSupported fails the import of the resources whose state cannot be read from the device,
+// reason telling why, rather than letting the framework report a generic error.
+func importNotSupported(resp *resource.ImportStateResponse, typeName, reason string) {
+	resp.Diagnostics.AddError("Import Not Supported", fmt.Sprintf("%s cannot be imported, %s.", typeName, reason))
+}
+
+// stringValueOrNull returns the value of an optional attribute the device reports empty when it
+// is not set.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
 
 func extractSubnet(cidr string) (int, string, error) {
 	ip, ipNet, err := net.ParseCIDR(cidr)
@@ -5,12 +5,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -20,8 +23,10 @@ import (
 )
 
 var _ resource.Resource = &CfgBackupResource{}
+var _ resource.ResourceWithImportState = &CfgBackupResource{}
 
-// var _ resource.ResourceWithImportState = &CfgBackupResource{}
+// cfgBackupImportUnsupported tells why f5os_config_backup cannot be imported.
+const cfgBackupImportUnsupported = "the remote server, the credentials and the local path the backup was exported and downloaded to are not kept on the system"
 
 func NewCfgBackupResource() resource.Resource {
 	return &CfgBackupResource{}
@@ -38,7 +43,10 @@ type CfgBackupResourceModel struct {
 	RemotePassword types.String `tfsdk:"remote_password"`
 	RemotePath     types.String `tfsdk:"remote_path"`
 	Protocol       types.String `tfsdk:"protocol"`
+	LocalPath      types.String `tfsdk:"local_path"`
 	Timeout        types.Int64  `tfsdk:"timeout"`
+	Triggers       types.Map    `tfsdk:"triggers"`
+	Sha256         types.String `tfsdk:"sha256"`
 	Id             types.String `tfsdk:"id"`
 }
 
@@ -48,7 +56,11 @@ func (r *CfgBackupResource) Metadata(ctx context.Context, req resource.MetadataR
 
 func (r *CfgBackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Resource used to manage F5OS config backup",
+		MarkdownDescription: "Resource used to manage F5OS config backup.\n\n" +
+			"The backup is created on the system, then optionally exported to a remote server and downloaded to a local path. " +
+			"Change `triggers`, for example with `timestamp()`, to snapshot the configuration again before every apply. " +
+			"Use `f5os_config_restore` to restore a backup.\n\n" +
+			"The resource cannot be imported, " + cfgBackupImportUnsupported + ".",
 
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -56,31 +68,46 @@ func (r *CfgBackupResource) Schema(ctx context.Context, req resource.SchemaReque
 				Required:            true,
 			},
 			"remote_host": schema.StringAttribute{
-				MarkdownDescription: "The hostname or IP address of the remote server used for storing the config backup file.",
-				Required:            true,
+				MarkdownDescription: "The hostname or IP address of the remote server used for storing the config backup file.\nWhen not set the backup is not exported.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("remote_user"),
+						path.MatchRoot("remote_password"),
+						path.MatchRoot("remote_path"),
+						path.MatchRoot("protocol"),
+					),
+				},
 			},
 			"remote_user": schema.StringAttribute{
-				MarkdownDescription: "User name for the remote server used for exporting the created config backup file.",
-				Required:            true,
+				MarkdownDescription: "User name for the remote server used for exporting the created config backup file.\nRequired with `remote_host`.",
+				Optional:            true,
 			},
 			"remote_password": schema.StringAttribute{
-				MarkdownDescription: "User password for the remote server used for exporting the created config backup file.",
+				MarkdownDescription: "User password for the remote server used for exporting the created config backup file.\nRequired with `remote_host`.",
 				Sensitive:           true,
-				Required:            true,
+				Optional:            true,
 			},
 			"remote_path": schema.StringAttribute{
-				MarkdownDescription: "The path on the remote server used for uploading the created config backup file.",
-				Required:            true,
+				MarkdownDescription: "The path on the remote server used for uploading the created config backup file.\nRequired with `remote_host`.",
+				Optional:            true,
 			},
 			"protocol": schema.StringAttribute{
-				MarkdownDescription: "Protocol for config backup file transfer.",
-				Required:            true,
+				MarkdownDescription: "Protocol for config backup file transfer.\nRequired with `remote_host`.",
+				Optional:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOf("scp", "https", "sftp"),
 				},
 			},
+			"local_path": schema.StringAttribute{
+				MarkdownDescription: "Path of a local file the created config backup is downloaded to, the file is replaced when it exists.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"timeout": schema.Int64Attribute{
-				MarkdownDescription: "The number of seconds to wait for config backup file export to finish. The value must be between 150 and 3600",
+				MarkdownDescription: "The number of seconds to wait for config backup file export or download to finish. The value must be between 150 and 3600",
 				Optional:            true,
 				Default:             int64default.StaticInt64(150),
 				Computed:            true,
@@ -88,6 +115,21 @@ func (r *CfgBackupResource) Schema(ctx context.Context, req resource.SchemaReque
 					int64validator.Between(150, 3600),
 				},
 			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will create the config backup again.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 checksum of the config backup downloaded to `local_path`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier for resource.",
 				Computed:            true,
@@ -121,6 +163,18 @@ func (r *CfgBackupResource) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("failure while creating config backup, got error: %s", err))
 		return
 	}
+	data.Sha256 = types.StringNull()
+	if !data.LocalPath.IsNull() {
+		tflog.Info(ctx, fmt.Sprintf("[CREATE] Downloading config backup %s to %s", name, data.LocalPath.ValueString()))
+		result, err := r.client.DownloadConfigBackup(name, data.LocalPath.ValueString(), &f5ossdk.DownloadOptions{
+			Timeout: time.Duration(timeout) * time.Second,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("failure while downloading config backup, got error: %s", err))
+			return
+		}
+		data.Sha256 = types.StringValue(result.SHA256)
+	}
 
 	data.Id = data.Name
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -168,7 +222,7 @@ func (r *CfgBackupResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if !exists {
-		req.State.RemoveResource(ctx)
+		resp.State.RemoveResource(ctx)
 	}
 }
 
@@ -180,6 +234,9 @@ func (r *CfgBackupResource) Update(ctx context.Context, req resource.UpdateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	// the backup is only created, exported and downloaded once, attributes changing it require a
+	// replacement
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *CfgBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -194,6 +251,10 @@ func (r *CfgBackupResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 }
 
+func (r *CfgBackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importNotSupported(resp, "f5os_config_backup", cfgBackupImportUnsupported)
+}
+
 func backupModelToExportConfig(model *CfgBackupResourceModel) f5ossdk.FileExport {
 	exportConfig := f5ossdk.FileExport{}
 	exportConfig.Insecure = ""
@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -147,3 +150,65 @@ resource "f5os_config_backup" "test" {
   protocol        = "https"
 }
 `
+
+func TestUnitCfgBackupDownload(t *testing.T) {
+	testAccPreUnitCheck(t)
+	exported := false
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-database:database/f5-database:config-backup", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method '%s', got '%s'", http.MethodPost, r.Method)
+		fmt.Fprint(w, `{"f5-database:output":{"result":"Database backup successful."}}`)
+	})
+	mux.HandleFunc("/restconf/data/f5-utils-file-transfer:file/export", func(w http.ResponseWriter, r *http.Request) {
+		exported = true
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	mux.HandleFunc("/restconf/data/f5-utils-file-transfer:file/f5-file-download:download-file/f5-file-download:start-download", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method '%s', got '%s'", http.MethodPost, r.Method)
+		assert.Equal(t, "pre_apply_backup", r.FormValue("file-name"))
+		assert.Equal(t, "configs/", r.FormValue("file-path"))
+		fmt.Fprint(w, "<config/>")
+	})
+	mux.HandleFunc("/restconf/data/f5-utils-file-transfer:file/list", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"f5-utils-file-transfer:output":{"entries":[{"name":"pre_apply_backup","date":"Tue Aug  1 06:02:35 UTC 2023","size":"45KB"}]}}`)
+	})
+	mux.HandleFunc("/restconf/data/f5-utils-file-transfer:file/delete", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"f5-utils-file-transfer:output":{"result":"Deleting the file"}}`)
+	})
+	defer teardown()
+
+	localPath := filepath.Join(t.TempDir(), "pre_apply_backup")
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "f5os_config_backup" "test" {
+  name       = "pre_apply_backup"
+  local_path = %q
+}
+`, localPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_config_backup.test", "sha256", "22b6aea43b874c33f01d0619be783f9109bd32869bbb9a675e6c54811793d831"),
+					func(s *terraform.State) error {
+						assert.False(t, exported, "Expected the backup kept on the system, got it exported")
+						content, err := os.ReadFile(localPath)
+						assert.NoError(t, err)
+						assert.Equal(t, "<config/>", string(content))
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
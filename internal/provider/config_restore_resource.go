@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CfgRestoreResource{}
+var _ resource.ResourceWithImportState = &CfgRestoreResource{}
+
+// cfgRestoreImportUnsupported tells why f5os_config_restore cannot be imported.
+const cfgRestoreImportUnsupported = "it restores the backup when it is created and leaves nothing to read on the system"
+
+func NewCfgRestoreResource() resource.Resource {
+	return &CfgRestoreResource{}
+}
+
+// CfgRestoreResource defines the resource implementation.
+type CfgRestoreResource struct {
+	client *f5ossdk.F5os
+}
+
+type CfgRestoreResourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	Id       types.String `tfsdk:"id"`
+}
+
+func (r *CfgRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_restore"
+}
+
+func (r *CfgRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource to restore the configuration of F5OS based systems from a config backup, as created by `f5os_config_backup`.\n\n" +
+			"The backup is restored when the resource is created, change `triggers` to restore it again.\n\n" +
+			"~> **NOTE** Restoring replaces the whole configuration of the system, resources managing the replaced configuration show differences on their next refresh. " +
+			"Destroying this resource only removes it from the Terraform state.\n\n" +
+			"The resource cannot be imported, " + cfgRestoreImportUnsupported + ".",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the config backup file to restore.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will restore the config backup again.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CfgRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *CfgRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CfgRestoreResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] Restoring config backup:%+v", name))
+	if err := r.client.RestoreConfigBackup(name); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("failure while restoring config backup, got error: %s", err))
+		return
+	}
+	data.Id = types.StringValue(fmt.Sprintf("%s-config-restore-%s", r.client.Host, name))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CfgRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CfgRestoreResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Restoring a backup is a one-time action, nothing to refresh from the device.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CfgRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CfgRestoreResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CfgRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CfgRestoreResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[DELETE] Config Restore:%+v removed from state", data.Id.ValueString()))
+}
+
+func (r *CfgRestoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importNotSupported(resp, "f5os_config_restore", cfgRestoreImportUnsupported)
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCfgRestoreTC1Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCfgRestoreConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_config_restore.known_good", "name", "test_cfg_backup"),
+					resource.TestCheckResourceAttrSet("f5os_config_restore.known_good", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCfgRestoreUnitTC1Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	var restored []string
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-database:database/f5-database:config-restore", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method '%s', got '%s'", http.MethodPost, r.Method)
+		body := make(map[string]string)
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		restored = append(restored, body["f5-database:name"])
+		fmt.Fprint(w, `{"f5-database:output":{"result":"Database config-restore successful."}}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCfgRestoreConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_config_restore.known_good", "name", "test_cfg_backup"),
+					func(s *terraform.State) error {
+						assert.Equal(t, []string{"test_cfg_backup"}, restored, "Expected the backup restored once, got %v", restored)
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCfgRestoreModifiedConfig,
+				Check: func(s *terraform.State) error {
+					assert.Equal(t, []string{"test_cfg_backup", "test_cfg_backup"}, restored, "Expected the backup restored again, got %v", restored)
+					return nil
+				},
+			},
+		},
+	})
+}
+
+const testAccCfgRestoreConfig = `
+resource "f5os_config_restore" "known_good" {
+  name = "test_cfg_backup"
+  triggers = {
+    change_ticket = "CHG0042"
+  }
+}
+`
+
+const testAccCfgRestoreModifiedConfig = `
+resource "f5os_config_restore" "known_good" {
+  name = "test_cfg_backup"
+  triggers = {
+    change_ticket = "CHG0043"
+  }
+}
+`
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &ControllerConfigSyncDataSource{}
+)
+
+func NewControllerConfigSyncDataSource() datasource.DataSource {
+	return &ControllerConfigSyncDataSource{}
+}
+
+// ControllerConfigSyncDataSource defines the data source implementation.
+type ControllerConfigSyncDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// ControllerConfigSyncDataSourceModel describes the data source data model.
+type ControllerConfigSyncDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	InSync           types.Bool   `tfsdk:"in_sync"`
+	SyncStatus       types.String `tfsdk:"sync_status"`
+	LastSyncTime     types.String `tfsdk:"last_sync_time"`
+	ActiveController types.String `tfsdk:"active_controller"`
+	Mode             types.String `tfsdk:"mode"`
+}
+
+func (d *ControllerConfigSyncDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_controller_config_sync"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *ControllerConfigSyncDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the configuration sync status of the VELOS system controllers.\n\n" +
+			"Use this data source at the Velos Controller level to check whether the configuration databases of both controllers are in sync before upgrades or failover.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"in_sync": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Set to `true` when the configuration databases of both controllers are in sync.",
+			},
+			"sync_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Configuration sync status as reported by the controllers.",
+			},
+			"last_sync_time": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the last configuration sync between the controllers.",
+			},
+			"active_controller": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Controller currently active, for example `controller-1`.",
+			},
+			"mode": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Redundancy mode of the controllers.",
+			},
+		},
+	}
+}
+
+func (d *ControllerConfigSyncDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *ControllerConfigSyncDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ControllerConfigSyncDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client.PlatformType != "Velos Controller" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_controller_config_sync` data source is supported with Velos Controller level.")
+		return
+	}
+	redundancy, err := d.client.GetControllerConfigSync()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Get Controller Config Sync Status", fmt.Sprintf("Error:%s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Controller Redundancy :%+v", redundancy))
+	data.SyncStatus = types.StringValue(redundancy.State.ConfigSyncStatus)
+	data.InSync = types.BoolValue(redundancy.State.ConfigSyncStatus == "in-sync")
+	data.LastSyncTime = types.StringValue(redundancy.State.LastConfigSyncTime)
+	data.ActiveController = types.StringValue(redundancy.State.CurrentActive)
+	data.Mode = types.StringValue(redundancy.State.Mode)
+	data.ID = types.StringValue(fmt.Sprintf("%s-config-sync", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccControllerConfigSyncDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControllerConfigSyncDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_controller_config_sync.test", "in_sync", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccControllerConfigSyncDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/velos_controller_components.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-controller-image:image", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/velos_controller_image.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-redundancy:redundancy/state", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"f5-system-redundancy:state": {"mode": "auto", "current-active": "controller-1", "config-sync-status": "in-sync", "last-config-sync-time": "2023-09-12T10:15:42Z"}}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControllerConfigSyncDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_controller_config_sync.test", "in_sync", "true"),
+					resource.TestCheckResourceAttr("data.f5os_controller_config_sync.test", "sync_status", "in-sync"),
+					resource.TestCheckResourceAttr("data.f5os_controller_config_sync.test", "active_controller", "controller-1"),
+					resource.TestCheckResourceAttr("data.f5os_controller_config_sync.test", "last_sync_time", "2023-09-12T10:15:42Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccControllerConfigSyncDataSourceUnitTC2(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccControllerConfigSyncDatasourceConfig,
+				ExpectError: regexp.MustCompile("supported with Velos Controller level"),
+			},
+		},
+	})
+}
+
+const testAccControllerConfigSyncDatasourceConfig = `
+data "f5os_controller_config_sync" "test" {
+}
+`
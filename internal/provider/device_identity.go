@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+const (
+	// deviceIdentityKey is the key of the device identity in the private state of the resources.
+	deviceIdentityKey = "f5os_device_identity"
+
+	deviceIdentityCheckWarn  = "warn"
+	deviceIdentityCheckError = "error"
+	deviceIdentityCheckOff   = "off"
+)
+
+// deviceIdentity identifies the device a resource was last read or changed on.
+type deviceIdentity struct {
+	Host       string `json:"host"`
+	CertSHA256 string `json:"cert_sha256"`
+}
+
+// deviceIdentityCheck is the identity of the device the provider is configured for and how a
+// change of it is reported.
+type deviceIdentityCheck struct {
+	identity atomic.Pointer[deviceIdentity]
+	mode     atomic.Value
+}
+
+// setDeviceIdentity records the identity of the device of client, checked against the one
+// recorded in the private state of the resources.
+func (p *F5osProvider) setDeviceIdentity(client *f5ossdk.F5os, mode string) {
+	if mode == "" {
+		mode = deviceIdentityCheckWarn
+	}
+	p.deviceIdentity.mode.Store(mode)
+	p.deviceIdentity.identity.Store(&deviceIdentity{Host: client.Host, CertSHA256: client.CertSHA256()})
+}
+
+// checkDeviceIdentity reports, as set by `device_identity_check`, that the device presents another
+// certificate than the one recorded in private, the private state of a resource of type typeName.
+func (p *F5osProvider) checkDeviceIdentity(typeName string, private []byte) []*tfprotov6.Diagnostic {
+	current := p.deviceIdentity.identity.Load()
+	mode, _ := p.deviceIdentity.mode.Load().(string)
+	if current == nil || current.CertSHA256 == "" || mode == deviceIdentityCheckOff {
+		return nil
+	}
+	recorded := privateDeviceIdentity(private)
+	if recorded == nil || recorded.CertSHA256 == "" || recorded.CertSHA256 == current.CertSHA256 {
+		return nil
+	}
+	severity := tfprotov6.DiagnosticSeverityWarning
+	if mode == deviceIdentityCheckError {
+		severity = tfprotov6.DiagnosticSeverityError
+	}
+	return []*tfprotov6.Diagnostic{{
+		Severity: severity,
+		Summary:  "F5OS Device Identity Changed",
+		Detail: fmt.Sprintf("The %s resource was last managed on the device at %s presenting the TLS certificate with SHA-256 fingerprint %s, "+
+			"the device at %s now presents the certificate %s.\n\n"+
+			"Another device may have taken over the management address. Check the device before applying changes, "+
+			"the new certificate is recorded once the resource is refreshed or changed after a certificate renewal.",
+			typeName, recorded.Host, recorded.CertSHA256, current.Host, current.CertSHA256),
+	}}
+}
+
+// recordDeviceIdentity returns private, the private state of a resource, holding the identity of
+// the device of the provider.
+func (p *F5osProvider) recordDeviceIdentity(ctx context.Context, private []byte) []byte {
+	current := p.deviceIdentity.identity.Load()
+	if current == nil || current.CertSHA256 == "" {
+		return private
+	}
+	// the private state is a JSON object of JSON values, shared with the framework
+	data := make(map[string][]byte)
+	if len(private) > 0 {
+		if err := json.Unmarshal(private, &data); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Unable to record the device identity in the private state: %s", err))
+			return private
+		}
+	}
+	identity, err := json.Marshal(current)
+	if err != nil {
+		return private
+	}
+	data[deviceIdentityKey] = identity
+	updated, err := json.Marshal(data)
+	if err != nil {
+		return private
+	}
+	return updated
+}
+
+func privateDeviceIdentity(private []byte) *deviceIdentity {
+	data := make(map[string][]byte)
+	if len(private) == 0 || json.Unmarshal(private, &data) != nil || len(data[deviceIdentityKey]) == 0 {
+		return nil
+	}
+	identity := &deviceIdentity{}
+	if json.Unmarshal(data[deviceIdentityKey], identity) != nil {
+		return nil
+	}
+	return identity
+}
+
+// isNullState reports whether state is the null state of a removed resource.
+func isNullState(state *tfprotov6.DynamicValue) bool {
+	if state == nil {
+		return true
+	}
+	value, err := state.Unmarshal(tftypes.DynamicPseudoType)
+	return err == nil && value.IsNull()
+}
@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DnsHostResource{}
+var _ resource.ResourceWithValidateConfig = &DnsHostResource{}
+var _ resource.ResourceWithImportState = &DnsHostResource{}
+
+func NewDnsHostResource() resource.Resource {
+	return &DnsHostResource{}
+}
+
+// DnsHostResource defines the resource implementation.
+type DnsHostResource struct {
+	client *f5ossdk.F5os
+}
+
+// DnsHostResourceModel describes the resource data model.
+type DnsHostResourceModel struct {
+	Hostname      types.String `tfsdk:"hostname"`
+	Ipv4Addresses types.List   `tfsdk:"ipv4_addresses"`
+	Ipv6Addresses types.List   `tfsdk:"ipv6_addresses"`
+	Aliases       types.List   `tfsdk:"aliases"`
+	Scope         types.String `tfsdk:"scope"`
+	Id            types.String `tfsdk:"id"`
+}
+
+func (r *DnsHostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_host"
+}
+
+func (r *DnsHostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource used to manage a static host entry of the resolver of F5OS systems, mapping a hostname to addresses without querying the DNS servers.\n\n" +
+			"Useful in isolated environments without full DNS, such as to resolve the hostnames of NTP, syslog or remote authentication servers. " +
+			"The F5OS versions that do not expose the host entries of the resolver fail the creation of the resource. The entry is removed from the device when the resource is destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname the entry resolves, changing it replaces the entry.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ipv4_addresses": schema.ListAttribute{
+				MarkdownDescription: "IPv4 addresses the hostname resolves to.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.AtLeastOneOf(path.MatchRoot("ipv6_addresses")),
+				},
+			},
+			"ipv6_addresses": schema.ListAttribute{
+				MarkdownDescription: "IPv6 addresses the hostname resolves to.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"aliases": schema.ListAttribute{
+				MarkdownDescription: "Other names resolving to the same addresses.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Level of the system the entry applies to, `controller` or `partition` on VELOS chassis and `appliance` on rSeries, following the address the provider connects to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DnsHostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *DnsHostResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *DnsHostResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateDnsHostAddresses(ctx, data.Ipv4Addresses, "ipv4_addresses", netip.Addr.Is4, &resp.Diagnostics)
+	validateDnsHostAddresses(ctx, data.Ipv6Addresses, "ipv6_addresses", netip.Addr.Is6, &resp.Diagnostics)
+}
+
+func (r *DnsHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DnsHostResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "[CREATE] DNS host entry")
+	r.configHostEntry(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = types.StringValue(data.Hostname.ValueString())
+	data.Scope = types.StringValue(r.client.ServiceScope())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DnsHostResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if serviceScopeMoved(ctx, r.client, &data.Scope, "DNS host entry") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	entry, err := r.client.GetDnsHostEntry(data.Id.ValueString())
+	if isNotFound(err) {
+		tflog.Warn(ctx, "DNS host entry no longer on the system, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get DNS host entry, got error: %s", err))
+		return
+	}
+	data.Hostname = types.StringValue(entry.Hostname)
+	data.Ipv4Addresses = dnsHostList(ctx, data.Ipv4Addresses, entry.Config.Ipv4Address, &resp.Diagnostics)
+	data.Ipv6Addresses = dnsHostList(ctx, data.Ipv6Addresses, entry.Config.Ipv6Address, &resp.Diagnostics)
+	data.Aliases = dnsHostList(ctx, data.Aliases, entry.Config.Alias, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DnsHostResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "[UPDATE] DNS host entry")
+	r.configHostEntry(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DnsHostResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.DeleteDnsHostEntry(data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Delete DNS host entry, got error: %s", err))
+	}
+}
+
+func (r *DnsHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// configHostEntry creates or replaces the host entry of data.
+func (r *DnsHostResource) configHostEntry(ctx context.Context, data *DnsHostResourceModel, diags *diag.Diagnostics) {
+	hostname := data.Hostname.ValueString()
+	entry := &f5ossdk.F5DnsHostEntry{Hostname: hostname, Config: f5ossdk.F5DnsHostEntryConfig{Hostname: hostname}}
+	diags.Append(data.Ipv4Addresses.ElementsAs(ctx, &entry.Config.Ipv4Address, false)...)
+	diags.Append(data.Ipv6Addresses.ElementsAs(ctx, &entry.Config.Ipv6Address, false)...)
+	diags.Append(data.Aliases.ElementsAs(ctx, &entry.Config.Alias, false)...)
+	if diags.HasError() {
+		return
+	}
+	err := r.client.DnsHostEntryConfig(entry)
+	var validationErr *f5ossdk.ValidationError
+	if errors.As(err, &validationErr) && validationErr.HasErrorTag("unknown-element") {
+		diags.AddError("Unsupported DNS Host Entries",
+			fmt.Sprintf("F5OS %s %s does not expose the static host entries of its resolver: %s", r.client.PlatformType, r.client.PlatformVersion, err))
+		return
+	}
+	if err != nil {
+		diags.AddError("F5OS Client Error:", fmt.Sprintf("Config DNS host entry %s failed, got error: %s", hostname, err))
+	}
+}
+
+// dnsHostList returns the list of values read from the device, null when the device reports none
+// and the attribute is not set.
+func dnsHostList(ctx context.Context, current types.List, values []string, diags *diag.Diagnostics) types.List {
+	if len(values) == 0 && current.IsNull() {
+		return current
+	}
+	list, d := types.ListValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	return list
+}
+
+// validateDnsHostAddresses reports the addresses of the attribute name that are not of the
+// address family is checks.
+func validateDnsHostAddresses(ctx context.Context, list types.List, name string, is func(netip.Addr) bool, diags *diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return
+	}
+	var addresses []types.String
+	diags.Append(list.ElementsAs(ctx, &addresses, false)...)
+	for i, address := range addresses {
+		if address.IsUnknown() || address.IsNull() {
+			continue
+		}
+		if addr, err := netip.ParseAddr(address.ValueString()); err != nil || !is(addr) || addr.Zone() != "" {
+			diags.AddAttributeError(path.Root(name).AtListIndex(i), "Invalid Address",
+				fmt.Sprintf("%q is not a valid address for %s.", address.ValueString(), name))
+		}
+	}
+}
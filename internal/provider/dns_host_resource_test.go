@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+func TestAccDnsHostTC1Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsHostConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_dns_host.ntp", "hostname", "ntp.example.net"),
+					resource.TestCheckResourceAttr("f5os_dns_host.ntp", "ipv4_addresses.0", "192.0.2.123"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDnsHostUnitTC1Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	var entry *f5ossdk.F5DnsHostEntry
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/dns/host-entries/host-entry=ntp.example.net", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			body := &f5ossdk.F5RespDnsHostEntries{}
+			_ = json.NewDecoder(r.Body).Decode(body)
+			assert.Len(t, body.HostEntry, 1)
+			entry = &body.HostEntry[0]
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			entry = nil
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			if entry == nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(&f5ossdk.F5RespDnsHostEntries{HostEntry: []f5ossdk.F5DnsHostEntry{*entry}})
+		}
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			assert.Nil(t, entry, "Expected the host entry removed, got %+v", entry)
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsHostConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_dns_host.ntp", "id", "ntp.example.net"),
+					resource.TestCheckResourceAttr("f5os_dns_host.ntp", "ipv4_addresses.#", "1"),
+					resource.TestCheckNoResourceAttr("f5os_dns_host.ntp", "aliases"),
+					resource.TestCheckResourceAttr("f5os_dns_host.ntp", "scope", "appliance"),
+				),
+			},
+			{
+				Config: testAccDnsHostModifiedConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_dns_host.ntp", "ipv6_addresses.0", "2001:db8::123"),
+					resource.TestCheckResourceAttr("f5os_dns_host.ntp", "aliases.0", "ntp"),
+					func(s *terraform.State) error {
+						assert.Equal(t, []string{"192.0.2.123", "192.0.2.124"}, entry.Config.Ipv4Address)
+						assert.Equal(t, []string{"ntp"}, entry.Config.Alias)
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "f5os_dns_host.ntp",
+				ImportState:       true,
+				ImportStateId:     "ntp.example.net",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDnsHostUnsupportedUnitTC2Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/dns/host-entries/host-entry=ntp.example.net", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprintf(w, "%s", `{"ietf-restconf:errors": {"error": [{"error-type": "application", "error-tag": "unknown-element", "error-message": "unknown element: host-entries in /oc-sys:system/oc-sys:dns"}]}}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDnsHostConfig,
+				ExpectError: regexp.MustCompile("does not expose the static host entries"),
+			},
+		},
+	})
+}
+
+func TestAccDnsHostInvalidAddressUnitTC3Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "f5os_dns_host" "ntp" {
+  hostname       = "ntp.example.net"
+  ipv4_addresses = ["2001:db8::123"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"2001:db8::123" is not a valid address for ipv4_addresses`),
+			},
+		},
+	})
+}
+
+const testAccDnsHostConfig = `
+resource "f5os_dns_host" "ntp" {
+  hostname       = "ntp.example.net"
+  ipv4_addresses = ["192.0.2.123"]
+}
+`
+
+const testAccDnsHostModifiedConfig = `
+resource "f5os_dns_host" "ntp" {
+  hostname       = "ntp.example.net"
+  ipv4_addresses = ["192.0.2.123", "192.0.2.124"]
+  ipv6_addresses = ["2001:db8::123"]
+  aliases        = ["ntp"]
+}
+`
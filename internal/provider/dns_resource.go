@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DnsResource{}
+var _ resource.ResourceWithImportState = &DnsResource{}
+
+func NewDnsResource() resource.Resource {
+	return &DnsResource{}
+}
+
+// DnsResource defines the resource implementation.
+type DnsResource struct {
+	client *f5ossdk.F5os
+}
+
+// DnsResourceModel describes the resource data model.
+type DnsResourceModel struct {
+	Servers       types.List   `tfsdk:"servers"`
+	SearchDomains types.List   `tfsdk:"search_domains"`
+	Scope         types.String `tfsdk:"scope"`
+	Id            types.String `tfsdk:"id"`
+}
+
+func (r *DnsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns"
+}
+
+func (r *DnsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource used to manage the DNS servers and search domains of F5OS systems.\n\n" +
+			"The resource manages the whole DNS configuration of the system, servers and search domains configured outside of Terraform show up as differences to remove. " +
+			"Updates only add and remove the entries that changed, new servers being added before the dropped ones are removed; the entries moved in the lists are removed and added again at their new position. " +
+			"The servers and search domains are removed from the device when the resource is destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"servers": schema.ListAttribute{
+				MarkdownDescription: "IPv4 or IPv6 addresses of the DNS servers, in the order they are queried.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"search_domains": schema.ListAttribute{
+				MarkdownDescription: "Domains appended to names that are not fully qualified when they are resolved, in the order they are tried.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Level of the system the configuration applies to, `controller` or `partition` on VELOS chassis and `appliance` on rSeries, following the address the provider connects to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DnsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *DnsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DnsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dnsConfig := getDnsConfig(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] DNS config :%+v", dnsConfig))
+	if err := r.client.SetDns(dnsConfig); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to configure DNS, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s-dns", r.client.Host))
+	data.Scope = types.StringValue(r.client.ServiceScope())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DnsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if serviceScopeMoved(ctx, r.client, &data.Scope, "DNS") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	dns, err := r.client.GetDns()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get DNS, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("DNS :%+v", dns))
+	servers, searchDomains := dnsEntries(dns)
+	var diags diag.Diagnostics
+	data.Servers, diags = types.ListValueFrom(ctx, types.StringType, servers)
+	resp.Diagnostics.Append(diags...)
+	if len(searchDomains) > 0 || !data.SearchDomains.IsNull() {
+		data.SearchDomains, diags = types.ListValueFrom(ctx, types.StringType, searchDomains)
+		resp.Diagnostics.Append(diags...)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DnsResourceModel
+	var state *DnsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var servers, searchDomains, haveServers, haveSearchDomains []string
+	resp.Diagnostics.Append(data.Servers.ElementsAs(ctx, &servers, false)...)
+	resp.Diagnostics.Append(data.SearchDomains.ElementsAs(ctx, &searchDomains, false)...)
+	resp.Diagnostics.Append(state.Servers.ElementsAs(ctx, &haveServers, false)...)
+	resp.Diagnostics.Append(state.SearchDomains.ElementsAs(ctx, &haveSearchDomains, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// the new entries are added before the dropped ones are removed, so that the system keeps
+	// resolving names, unless they need to follow the entries moved
+	serversFirst, serversRemoved, serversLast := orderedListUpdate(haveServers, servers)
+	domainsFirst, domainsRemoved, domainsLast := orderedListUpdate(haveSearchDomains, searchDomains)
+	if !r.setDnsEntries(ctx, serversFirst, domainsFirst, &resp.Diagnostics) {
+		return
+	}
+	for _, server := range serversRemoved {
+		if err := r.client.RemoveDnsServer(server); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove DNS server %s, got error: %s", server, err))
+			return
+		}
+	}
+	for _, domain := range domainsRemoved {
+		if err := r.client.RemoveDnsSearchDomain(domain); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove DNS search domain %s, got error: %s", domain, err))
+			return
+		}
+	}
+	if !r.setDnsEntries(ctx, serversLast, domainsLast, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DnsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var servers, searchDomains []string
+	resp.Diagnostics.Append(data.Servers.ElementsAs(ctx, &servers, false)...)
+	resp.Diagnostics.Append(data.SearchDomains.ElementsAs(ctx, &searchDomains, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[DELETE] Removing DNS servers %+v and search domains %+v", servers, searchDomains))
+	for _, server := range servers {
+		if err := r.client.RemoveDnsServer(server); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove DNS server %s, got error: %s", server, err))
+			return
+		}
+	}
+	for _, domain := range searchDomains {
+		if err := r.client.RemoveDnsSearchDomain(domain); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove DNS search domain %s, got error: %s", domain, err))
+			return
+		}
+	}
+}
+
+func (r *DnsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s-dns", r.client.Host))...)
+}
+
+// setDnsEntries appends servers and searchDomains to the DNS configuration of the system, it
+// reports whether it succeeded.
+func (r *DnsResource) setDnsEntries(ctx context.Context, servers, searchDomains []string, diags *diag.Diagnostics) bool {
+	if len(servers) == 0 && len(searchDomains) == 0 {
+		return true
+	}
+	dnsConfig := dnsEntriesConfig(servers, searchDomains)
+	tflog.Info(ctx, fmt.Sprintf("[UPDATE] DNS config :%+v", dnsConfig))
+	if err := r.client.SetDns(dnsConfig); err != nil {
+		diags.AddError("F5OS Client Error", fmt.Sprintf("Unable to configure DNS, got error: %s", err))
+		return false
+	}
+	return true
+}
+
+func getDnsConfig(ctx context.Context, data *DnsResourceModel, diags *diag.Diagnostics) *f5ossdk.F5ReqDns {
+	var servers, searchDomains []string
+	diags.Append(data.Servers.ElementsAs(ctx, &servers, false)...)
+	diags.Append(data.SearchDomains.ElementsAs(ctx, &searchDomains, false)...)
+	return dnsEntriesConfig(servers, searchDomains)
+}
+
+func dnsEntriesConfig(servers, searchDomains []string) *f5ossdk.F5ReqDns {
+	dnsConfig := &f5ossdk.F5ReqDns{}
+	if len(servers) > 0 {
+		dnsConfig.Dns.Servers = &f5ossdk.F5DnsServers{}
+	}
+	for _, address := range servers {
+		server := f5ossdk.F5DnsServer{Address: address}
+		server.Config.Address = address
+		dnsConfig.Dns.Servers.Server = append(dnsConfig.Dns.Servers.Server, server)
+	}
+	if len(searchDomains) > 0 {
+		dnsConfig.Dns.Config = &f5ossdk.F5DnsConfig{Search: searchDomains}
+	}
+	return dnsConfig
+}
+
+func dnsEntries(dns *f5ossdk.F5ReqDns) ([]string, []string) {
+	servers := []string{}
+	if dns.Dns.Servers != nil {
+		for _, server := range dns.Dns.Servers.Server {
+			servers = append(servers, server.Address)
+		}
+	}
+	searchDomains := []string{}
+	if dns.Dns.Config != nil {
+		searchDomains = append(searchDomains, dns.Dns.Config.Search...)
+	}
+	return servers, searchDomains
+}
+
+// serviceScopeMoved reports whether the configuration of a system service in state was applied at
+// another level of the system than the one the provider now connects to, the controllers and the
+// partitions of a VELOS chassis having their own. scope is set for states created without it.
+func serviceScopeMoved(ctx context.Context, client *f5ossdk.F5os, scope *types.String, service string) bool {
+	current := client.ServiceScope()
+	if scope.IsNull() || scope.IsUnknown() {
+		*scope = types.StringValue(current)
+	}
+	if scope.ValueString() == current {
+		return false
+	}
+	tflog.Warn(ctx, fmt.Sprintf("%s configuration was applied at the %s level, the provider now targets the %s level, removing it from state", service, scope.ValueString(), current))
+	return true
+}
+
+// stringListDifference returns the entries of a that are not in b, in the order of a.
+func stringListDifference(a, b []string) []string {
+	seen := make(map[string]bool, len(b))
+	for _, val := range b {
+		seen[val] = true
+	}
+	var diff []string
+	for _, val := range a {
+		if !seen[val] {
+			diff = append(diff, val)
+		}
+	}
+	return diff
+}
+
+// listPositions returns the function giving the position of key in keys, the keys of the entries
+// of a list in state, len(keys) for the keys not in it.
+func listPositions(keys []string) func(key string) int {
+	positions := make(map[string]int, len(keys))
+	for i, key := range keys {
+		positions[key] = i
+	}
+	return func(key string) int {
+		if position, ok := positions[key]; ok {
+			return position
+		}
+		return len(keys)
+	}
+}
+
+// orderedListUpdate returns how to turn the ordered list have into want with the fewest changes:
+// the entries to append before any removal, the entries to remove, then the entries to append
+// after the removals. Entries in place are left alone, the ones moved are removed and appended
+// again, as appending is the only way to position an entry.
+func orderedListUpdate(have, want []string) (appendFirst, remove, appendLast []string) {
+	// the longest start of want found in have in the same order stays in place
+	kept, next := 0, 0
+	for kept < len(want) {
+		for next < len(have) && have[next] != want[kept] {
+			next++
+		}
+		if next == len(have) {
+			break
+		}
+		kept++
+		next++
+	}
+	remove = stringListDifference(have, want[:kept])
+	rest := want[kept:]
+	// appending the entries not on the system is only safe until the first moved one
+	onSystem := make(map[string]bool, len(have))
+	for _, val := range have {
+		onSystem[val] = true
+	}
+	first := 0
+	for first < len(rest) && !onSystem[rest[first]] {
+		first++
+	}
+	return rest[:first], remove, rest[first:]
+}
@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+func TestAccDnsTC1Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_dns.dns", "servers.0", "192.0.2.53"),
+					resource.TestCheckResourceAttr("f5os_dns.dns", "search_domains.0", "example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDnsUnitTC1Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	var servers, searchDomains, removed []string
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/dns", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			body := &f5ossdk.F5ReqDns{}
+			_ = json.NewDecoder(r.Body).Decode(body)
+			if body.Dns.Servers != nil {
+				for _, server := range body.Dns.Servers.Server {
+					servers = appendMissing(servers, server.Address)
+				}
+			}
+			if body.Dns.Config != nil {
+				for _, domain := range body.Dns.Config.Search {
+					searchDomains = appendMissing(searchDomains, domain)
+				}
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		dns := &f5ossdk.F5ReqDns{}
+		dns.Dns.Servers = &f5ossdk.F5DnsServers{}
+		for _, address := range servers {
+			dns.Dns.Servers.Server = append(dns.Dns.Servers.Server, f5ossdk.F5DnsServer{Address: address})
+		}
+		dns.Dns.Config = &f5ossdk.F5DnsConfig{Search: searchDomains}
+		_ = json.NewEncoder(w).Encode(dns)
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/dns/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		entry, _ := url.PathUnescape(r.URL.EscapedPath()[strings.LastIndex(r.URL.EscapedPath(), "=")+1:])
+		removed = append(removed, entry)
+		servers = stringListDifference(servers, []string{entry})
+		searchDomains = stringListDifference(searchDomains, []string{entry})
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			assert.Empty(t, servers, "Expected every DNS server removed, got %v", servers)
+			assert.Empty(t, searchDomains, "Expected every search domain removed, got %v", searchDomains)
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_dns.dns", "servers.#", "2"),
+					resource.TestCheckResourceAttr("f5os_dns.dns", "servers.0", "192.0.2.53"),
+					resource.TestCheckResourceAttr("f5os_dns.dns", "search_domains.0", "example.com"),
+				),
+			},
+			{
+				Config: testAccDnsModifiedConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_dns.dns", "servers.#", "1"),
+					resource.TestCheckResourceAttr("f5os_dns.dns", "servers.0", "192.0.2.53"),
+					resource.TestCheckNoResourceAttr("f5os_dns.dns", "search_domains.0"),
+					func(s *terraform.State) error {
+						assert.Equal(t, []string{"2001:db8::53", "example.com"}, removed, "Expected the dropped server and domain removed, got %v", removed)
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "f5os_dns.dns",
+				ImportState:       true,
+				ImportStateId:     "dns",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDnsPartitionScopeUnitTC2Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	var partition = false
+	var patched = 0
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if partition {
+			_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/velos_partition_components.json"))
+			return
+		}
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/velos_controller_components.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-controller-image:image", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/velos_controller_image.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/dns", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			patched++
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, _ = fmt.Fprintf(w, "%s", `{"openconfig-system:dns": {"config": {"search": ["example.com"]}, "servers": {"server": [{"address": "192.0.2.53", "config": {"address": "192.0.2.53"}}, {"address": "2001:db8::53", "config": {"address": "2001:db8::53"}}]}}}`)
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/dns/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_dns.dns", "scope", "controller"),
+				),
+			},
+			{
+				// the provider now connects to a chassis partition, its DNS configuration is applied there
+				PreConfig: func() { partition = true },
+				Config:    testAccDnsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_dns.dns", "scope", "partition"),
+					func(s *terraform.State) error {
+						assert.Equal(t, 2, patched, "Expected DNS configured at both levels, got %d configurations", patched)
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccDnsConfigLockedUnitTC3Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	// the locked datastore is not retried, the error is expected right away
+	t.Setenv("F5OS_RETRIES", "0")
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/dns", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		w.WriteHeader(http.StatusConflict)
+		_, _ = fmt.Fprintf(w, "%s", `{"ietf-restconf:errors": {"error": [{"error-type": "protocol", "error-tag": "lock-denied", "error-message": "the configuration database is locked"}]}}`)
+	})
+	mux.HandleFunc("/restconf/data/ietf-netconf-monitoring:netconf-state/datastores", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		_, _ = fmt.Fprintf(w, "%s", `{"ietf-netconf-monitoring:datastores": {"datastore": [
+			{"name": "running", "locks": {"global-lock": {"locked-by-session": 117, "locked-time": "2023-06-12T09:14:03Z"}}},
+			{"name": "candidate"}]}}`)
+	})
+	mux.HandleFunc("/restconf/data/tailf-aaa:aaa/sessions", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, "%s", `{"tailf-aaa:sessions": {"session": [
+			{"session-id": 117, "username": "operator", "protocol": "ssh", "context": "cli", "from-host": "10.192.10.54"},
+			{"session-id": 118, "username": "testuser", "protocol": "https", "context": "restconf", "from-host": "10.192.10.60"}]}}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDnsConfig,
+				ExpectError: regexp.MustCompile(`running datastore locked by session 117 of operator from 10\.192\.10\.54 \(cli\)\s+since 2023-06-12T09:14:03Z`),
+			},
+		},
+	})
+}
+
+func appendMissing(list []string, entry string) []string {
+	for _, val := range list {
+		if val == entry {
+			return list
+		}
+	}
+	return append(list, entry)
+}
+
+func TestUnitOrderedListUpdate(t *testing.T) {
+	for _, tc := range []struct {
+		have, want                      []string
+		appendFirst, remove, appendLast []string
+	}{
+		{have: []string{"a", "b"}, want: []string{"a", "b", "c"}, appendFirst: []string{"c"}, appendLast: []string{}},
+		{have: []string{"a", "b", "c"}, want: []string{"a", "c"}, remove: []string{"b"}, appendFirst: []string{}, appendLast: []string{}},
+		{have: []string{"a"}, want: []string{"b"}, appendFirst: []string{"b"}, remove: []string{"a"}, appendLast: []string{}},
+		{have: []string{"a", "b"}, want: []string{"b", "a"}, appendFirst: []string{}, remove: []string{"a"}, appendLast: []string{"a"}},
+		{have: []string{"a", "c"}, want: []string{"a", "b", "c", "d"}, appendFirst: []string{"b"}, remove: []string{"c"}, appendLast: []string{"c", "d"}},
+	} {
+		appendFirst, remove, appendLast := orderedListUpdate(tc.have, tc.want)
+		assert.Equal(t, tc.appendFirst, appendFirst, "entries appended first turning %v into %v", tc.have, tc.want)
+		assert.Equal(t, tc.remove, remove, "entries removed turning %v into %v", tc.have, tc.want)
+		assert.Equal(t, tc.appendLast, appendLast, "entries appended last turning %v into %v", tc.have, tc.want)
+	}
+}
+
+const testAccDnsConfig = `
+resource "f5os_dns" "dns" {
+  servers        = ["192.0.2.53", "2001:db8::53"]
+  search_domains = ["example.com"]
+}
+`
+
+const testAccDnsModifiedConfig = `
+resource "f5os_dns" "dns" {
+  servers        = ["192.0.2.53"]
+  search_domains = []
+}
+`
@@ -15,8 +15,10 @@ import (
 )
 
 var _ resource.Resource = &PartitionCertKeyResource{}
+var _ resource.ResourceWithImportState = &PartitionCertKeyResource{}
 
-// var _ resource.ResourceWithImportState = &PartitionCertKeyResource{}
+// certKeyImportUnsupported tells why f5os_tls_cert_key cannot be imported.
+const certKeyImportUnsupported = "the private key and its passphrase are not readable from the partition"
 
 func NewPartitionCertKeyResource() resource.Resource {
 	return &PartitionCertKeyResource{}
@@ -52,7 +54,8 @@ func (r *PartitionCertKeyResource) Metadata(ctx context.Context, req resource.Me
 
 func (r *PartitionCertKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Resource used to manage tls cert and key on F5OS partitions",
+		MarkdownDescription: "Resource used to manage tls cert and key on F5OS partitions\n\n" +
+			"The resource cannot be imported, " + certKeyImportUnsupported + ".",
 
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -238,6 +241,10 @@ func (r *PartitionCertKeyResource) Delete(ctx context.Context, req resource.Dele
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *PartitionCertKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importNotSupported(resp, "f5os_tls_cert_key", certKeyImportUnsupported)
+}
+
 func getTLSConfig(data *PartitionCertKeyResourceModel) *f5ossdk.TlsCertKey {
 
 	certKeyConfig := &f5ossdk.TlsCertKey{
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &ImportsDataSource{}
+)
+
+// importResourceTypes are the resource types the imports data source enumerates, in the order
+// their imports are reported.
+var importResourceTypes = []string{"f5os_vlan", "f5os_interface", "f5os_lag"}
+
+// importNameInvalidChars matches the characters of device object names not allowed in Terraform
+// resource names.
+var importNameInvalidChars = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
+func NewImportsDataSource() datasource.DataSource {
+	return &ImportsDataSource{}
+}
+
+// ImportsDataSource defines the data source implementation.
+type ImportsDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// ImportsDataSourceModel describes the data source data model.
+type ImportsDataSourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	ResourceTypes []types.String `tfsdk:"resource_types"`
+	Imports       []ImportModel  `tfsdk:"imports"`
+	ImportBlocks  types.String   `tfsdk:"import_blocks"`
+}
+
+type ImportModel struct {
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceName types.String `tfsdk:"resource_name"`
+	ImportID     types.String `tfsdk:"import_id"`
+}
+
+func (d *ImportsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_imports"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *ImportsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Enumerate the VLANs, interfaces and LAGs already configured on F5OS based systems like chassis partitions or rSeries platforms, with the IDs to import them with.\n\n" +
+			"Use this data source to bring a configured system under Terraform management: write `import_blocks` to a `.tf` file, then run `terraform plan -generate-config-out=generated.tf` (Terraform 1.5 or later) to generate the configuration of the imported resources.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"resource_types": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: fmt.Sprintf("Resource types to enumerate, among %s, default is all of them.", "`"+strings.Join(importResourceTypes, "`, `")+"`"),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(importResourceTypes...)),
+				},
+			},
+			"imports": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of the objects of the system, VLANs first, then interfaces, then LAGs.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the resource managing the object, for example `f5os_vlan`.",
+						},
+						"resource_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Terraform resource name suggested for the object, derived from its name on the system, for example `vlan_10` or `interface_1_0`.",
+						},
+						"import_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "ID to import the object with, for example `10` for a VLAN or `1.0` for an interface.",
+						},
+					},
+				},
+			},
+			"import_blocks": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Terraform `import` blocks of the objects of `imports`.",
+			},
+		},
+	}
+}
+
+func (d *ImportsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *ImportsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ImportsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client.PlatformType == "Velos Controller" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_imports` data source is supported with Velos Partition level/rSeries appliance.")
+		return
+	}
+	resourceTypes := make(map[string]bool)
+	for _, resourceType := range data.ResourceTypes {
+		resourceTypes[resourceType.ValueString()] = true
+	}
+	wanted := func(resourceType string) bool {
+		return len(resourceTypes) == 0 || resourceTypes[resourceType]
+	}
+
+	data.Imports = []ImportModel{}
+	if wanted("f5os_vlan") {
+		vlans, err := d.client.GetVlans()
+		if err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Unable to List VLANs, got error: %s", err))
+			return
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Vlans :%+v", vlans))
+		sort.Slice(vlans, func(i, j int) bool { return vlans[i].VlanID < vlans[j].VlanID })
+		for _, vlan := range vlans {
+			data.Imports = append(data.Imports, importModel("f5os_vlan", fmt.Sprintf("vlan_%d", vlan.VlanID), fmt.Sprintf("%d", vlan.VlanID)))
+		}
+	}
+	if wanted("f5os_interface") || wanted("f5os_lag") {
+		intfs, err := d.client.GetInterfaces()
+		if err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Unable to List Interfaces, got error: %s", err))
+			return
+		}
+		var lags []ImportModel
+		for _, intf := range intfs {
+			intfType := intf.State.Type
+			if intfType == "" {
+				intfType = intf.Config.Type
+			}
+			switch strings.TrimPrefix(intfType, "iana-if-type:") {
+			case "ethernetCsmacd":
+				if wanted("f5os_interface") {
+					data.Imports = append(data.Imports, importModel("f5os_interface", "interface_"+intf.Name, intf.Name))
+				}
+			case "ieee8023adLag":
+				if wanted("f5os_lag") {
+					lags = append(lags, importModel("f5os_lag", "lag_"+intf.Name, intf.Name))
+				}
+			}
+		}
+		data.Imports = append(data.Imports, lags...)
+	}
+	data.ImportBlocks = types.StringValue(importBlocks(data.Imports))
+	data.ID = types.StringValue(fmt.Sprintf("%s-imports", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// importModel returns the import of the object id under the resource resourceType.name, name
+// being made a valid Terraform resource name.
+func importModel(resourceType, name, id string) ImportModel {
+	return ImportModel{
+		ResourceType: types.StringValue(resourceType),
+		ResourceName: types.StringValue(importNameInvalidChars.ReplaceAllString(name, "_")),
+		ImportID:     types.StringValue(id),
+	}
+}
+
+func importBlocks(imports []ImportModel) string {
+	blocks := make([]string, 0, len(imports))
+	for _, imp := range imports {
+		blocks = append(blocks, fmt.Sprintf("import {\n  to = %s.%s\n  id = %q\n}\n", imp.ResourceType.ValueString(), imp.ResourceName.ValueString(), imp.ImportID.ValueString()))
+	}
+	return strings.Join(blocks, "\n")
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccImportsDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImportsDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_imports.test", "imports.#"),
+					resource.TestCheckResourceAttrSet("data.f5os_imports.test", "import_blocks"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImportsDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-vlan:vlans", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/vlans_list.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/interfaces_inventory.json"))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImportsDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_imports.test", "imports.#", "5"),
+					resource.TestCheckResourceAttr("data.f5os_imports.test", "imports.0.resource_type", "f5os_vlan"),
+					resource.TestCheckResourceAttr("data.f5os_imports.test", "imports.0.resource_name", "vlan_10"),
+					resource.TestCheckResourceAttr("data.f5os_imports.test", "imports.0.import_id", "10"),
+					resource.TestCheckResourceAttr("data.f5os_imports.test", "imports.2.resource_type", "f5os_interface"),
+					resource.TestCheckResourceAttr("data.f5os_imports.test", "imports.2.resource_name", "interface_1_0"),
+					resource.TestCheckResourceAttr("data.f5os_imports.test", "imports.2.import_id", "1.0"),
+					resource.TestCheckResourceAttr("data.f5os_imports.test", "imports.4.resource_type", "f5os_lag"),
+					resource.TestCheckResourceAttr("data.f5os_imports.test", "imports.4.import_id", "lag1"),
+					resource.TestCheckResourceAttr("data.f5os_imports.test", "import_blocks", testAccImportsDatasourceBlocks),
+				),
+			},
+			{
+				Config: testAccImportsDatasourceLagConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_imports.test", "imports.#", "1"),
+					resource.TestCheckResourceAttr("data.f5os_imports.test", "imports.0.resource_name", "lag_lag1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccImportsDatasourceConfig = `
+data "f5os_imports" "test" {}
+`
+
+const testAccImportsDatasourceLagConfig = `
+data "f5os_imports" "test" {
+  resource_types = ["f5os_lag"]
+}
+`
+
+const testAccImportsDatasourceBlocks = `import {
+  to = f5os_vlan.vlan_10
+  id = "10"
+}
+
+import {
+  to = f5os_vlan.vlan_11
+  id = "11"
+}
+
+import {
+  to = f5os_interface.interface_1_0
+  id = "1.0"
+}
+
+import {
+  to = f5os_interface.interface_2_0
+  id = "2.0"
+}
+
+import {
+  to = f5os_lag.lag_lag1
+  id = "lag1"
+}
+`
@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &InterfaceDescriptionsResource{}
+var _ resource.ResourceWithImportState = &InterfaceDescriptionsResource{}
+
+func NewInterfaceDescriptionsResource() resource.Resource {
+	return &InterfaceDescriptionsResource{}
+}
+
+// InterfaceDescriptionsResource defines the resource implementation.
+type InterfaceDescriptionsResource struct {
+	client *f5ossdk.F5os
+}
+
+// InterfaceDescriptionsResourceModel describes the resource data model.
+type InterfaceDescriptionsResourceModel struct {
+	Descriptions types.Map    `tfsdk:"descriptions"`
+	Id           types.String `tfsdk:"id"`
+}
+
+func (r *InterfaceDescriptionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interface_descriptions"
+}
+
+func (r *InterfaceDescriptionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource used to set the description of many interfaces at once on F5OS systems like VELOS chassis partitions or rSeries platforms.\n\n" +
+			"All descriptions are applied with a single API call, for example to label every port from a cabling sheet. " +
+			"The descriptions of the interfaces removed from the map, or of all interfaces when the resource is destroyed, are removed from the device.",
+
+		Attributes: map[string]schema.Attribute{
+			"descriptions": schema.MapAttribute{
+				MarkdownDescription: "Map of interface name, for example `1.0`, to the description to set on the interface.",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *InterfaceDescriptionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *InterfaceDescriptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *InterfaceDescriptionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client.PlatformType == "Velos Controller" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_interface_descriptions` resource is supported with Velos Partition level/rSeries appliance.")
+		return
+	}
+
+	descriptions := make(map[string]string)
+	resp.Diagnostics.Append(data.Descriptions.ElementsAs(ctx, &descriptions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] Setting descriptions of %d interfaces", len(descriptions)))
+	resp.Diagnostics.Append(r.setDescriptions(descriptions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s-interface-descriptions", r.client.Host))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InterfaceDescriptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *InterfaceDescriptionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := make(map[string]string)
+	resp.Diagnostics.Append(data.Descriptions.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	interfaces, err := r.client.GetInterfaces()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get interfaces, got error: %s", err))
+		return
+	}
+	// only the interfaces managed by the resource are reported, a description removed on the
+	// device shows up as a difference to apply again, an imported resource manages every
+	// interface with a description
+	descriptions := make(map[string]string)
+	for _, intf := range interfaces {
+		if _, ok := managed[intf.Name]; (ok || data.Descriptions.IsNull()) && intf.Config.Description != "" {
+			descriptions[intf.Name] = intf.Config.Description
+		}
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Interface descriptions :%+v", descriptions))
+	var diags diag.Diagnostics
+	data.Descriptions, diags = types.MapValueFrom(ctx, types.StringType, descriptions)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InterfaceDescriptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *InterfaceDescriptionsResourceModel
+	var state *InterfaceDescriptionsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client.PlatformType == "Velos Controller" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_interface_descriptions` resource is supported with Velos Partition level/rSeries appliance.")
+		return
+	}
+
+	descriptions := make(map[string]string)
+	haveDescriptions := make(map[string]string)
+	resp.Diagnostics.Append(data.Descriptions.ElementsAs(ctx, &descriptions, false)...)
+	resp.Diagnostics.Append(state.Descriptions.ElementsAs(ctx, &haveDescriptions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var removed []string
+	for name := range haveDescriptions {
+		if _, ok := descriptions[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	tflog.Info(ctx, fmt.Sprintf("[UPDATE] Setting descriptions of %d interfaces, removing %d", len(descriptions), len(removed)))
+	if err := r.client.RemoveInterfaceDescriptions(removed); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove interface descriptions, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(r.setDescriptions(descriptions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InterfaceDescriptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *InterfaceDescriptionsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	descriptions := make(map[string]string)
+	resp.Diagnostics.Append(data.Descriptions.ElementsAs(ctx, &descriptions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var names []string
+	for name := range descriptions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	tflog.Info(ctx, fmt.Sprintf("[DELETE] Removing descriptions of %d interfaces", len(names)))
+	if err := r.client.RemoveInterfaceDescriptions(names); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove interface descriptions, got error: %s", err))
+		return
+	}
+}
+
+func (r *InterfaceDescriptionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s-interface-descriptions", r.client.Host))...)
+}
+
+// setDescriptions checks that every interface exists, a PATCH on an unknown interface name
+// would try to create it, and applies all descriptions at once.
+func (r *InterfaceDescriptionsResource) setDescriptions(descriptions map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(descriptions) == 0 {
+		return diags
+	}
+	interfaces, err := r.client.GetInterfaces()
+	if err != nil {
+		diags.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get interfaces, got error: %s", err))
+		return diags
+	}
+	known := make(map[string]bool)
+	for _, intf := range interfaces {
+		known[intf.Name] = true
+	}
+	var unknown []string
+	for name, description := range descriptions {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+		if description == "" {
+			diags.AddError("Parameter Error:", fmt.Sprintf("Description of interface %s is empty, remove the interface from `descriptions` instead", name))
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		diags.AddError("Parameter Error:", fmt.Sprintf("Interfaces not found on the device: %s", strings.Join(unknown, ", ")))
+	}
+	if diags.HasError() {
+		return diags
+	}
+	if err := r.client.SetInterfaceDescriptions(descriptions); err != nil {
+		diags.AddError("F5OS Client Error", fmt.Sprintf("Unable to set interface descriptions, got error: %s", err))
+	}
+	return diags
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccInterfaceDescriptionsTC1Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInterfaceDescriptionsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_interface_descriptions.cabling", "descriptions.1.0", "uplink core-sw1 et-0/0/1"),
+					resource.TestCheckResourceAttr("f5os_interface_descriptions.cabling", "descriptions.2.0", "uplink core-sw2 et-0/0/1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInterfaceDescriptionsUnitTC1Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	descriptions := map[string]string{}
+	patches := 0
+	var removed []string
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			patches++
+			body := make(map[string]map[string][]map[string]any)
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			for _, intf := range body["openconfig-interfaces:interfaces"]["interface"] {
+				descriptions[intf["name"].(string)] = intf["config"].(map[string]any)["description"].(string)
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		var interfaces []string
+		for _, name := range []string{"1.0", "2.0", "3.0"} {
+			interfaces = append(interfaces, fmt.Sprintf(`{"name":"%s","config":{"name":"%s","description":"%s"}}`, name, name, descriptions[name]))
+		}
+		_, _ = fmt.Fprintf(w, `{"openconfig-interfaces:interfaces":{"interface":[%s]}}`, strings.Join(interfaces, ","))
+	})
+	for _, name := range []string{"1.0", "2.0", "3.0"} {
+		name := name
+		mux.HandleFunc(fmt.Sprintf("/restconf/data/openconfig-interfaces:interfaces/interface=%s/config/description", name), func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+			removed = append(removed, name)
+			delete(descriptions, name)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			sort.Strings(removed)
+			assert.Equal(t, []string{"1.0", "2.0", "3.0"}, removed, "Expected every description removed, got %v", removed)
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInterfaceDescriptionsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_interface_descriptions.cabling", "descriptions.%", "2"),
+					resource.TestCheckResourceAttr("f5os_interface_descriptions.cabling", "descriptions.1.0", "uplink core-sw1 et-0/0/1"),
+					func(s *terraform.State) error {
+						assert.Equal(t, 1, patches, "Expected descriptions applied in one PATCH, got %d", patches)
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccInterfaceDescriptionsModifiedConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_interface_descriptions.cabling", "descriptions.%", "2"),
+					resource.TestCheckResourceAttr("f5os_interface_descriptions.cabling", "descriptions.3.0", "server rack12 eth0"),
+					resource.TestCheckNoResourceAttr("f5os_interface_descriptions.cabling", "descriptions.2.0"),
+				),
+			},
+			{
+				ResourceName:      "f5os_interface_descriptions.cabling",
+				ImportState:       true,
+				ImportStateId:     "interface-descriptions",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccInterfaceDescriptionsConfig = `
+resource "f5os_interface_descriptions" "cabling" {
+  descriptions = {
+    "1.0" = "uplink core-sw1 et-0/0/1"
+    "2.0" = "uplink core-sw2 et-0/0/1"
+  }
+}
+`
+
+const testAccInterfaceDescriptionsModifiedConfig = `
+resource "f5os_interface_descriptions" "cabling" {
+  descriptions = {
+    "1.0" = "uplink core-sw1 et-0/0/1"
+    "3.0" = "server rack12 eth0"
+  }
+}
+`
@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &InterfaceErrorRatesDataSource{}
+)
+
+func NewInterfaceErrorRatesDataSource() datasource.DataSource {
+	return &InterfaceErrorRatesDataSource{}
+}
+
+// InterfaceErrorRatesDataSource defines the data source implementation.
+type InterfaceErrorRatesDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// InterfaceErrorRatesDataSourceModel describes the data source data model.
+type InterfaceErrorRatesDataSourceModel struct {
+	ID             types.String              `tfsdk:"id"`
+	Names          []types.String            `tfsdk:"names"`
+	SampleInterval types.Int64               `tfsdk:"sample_interval"`
+	Threshold      types.Float64             `tfsdk:"threshold"`
+	MaxErrorRate   types.Float64             `tfsdk:"max_error_rate"`
+	MaxDiscardRate types.Float64             `tfsdk:"max_discard_rate"`
+	AboveThreshold []types.String            `tfsdk:"above_threshold"`
+	Interfaces     []InterfaceErrorRateModel `tfsdk:"interfaces"`
+}
+
+type InterfaceErrorRateModel struct {
+	Name            types.String  `tfsdk:"name"`
+	OperStatus      types.String  `tfsdk:"oper_status"`
+	Packets         types.Int64   `tfsdk:"packets"`
+	InErrors        types.Int64   `tfsdk:"in_errors"`
+	OutErrors       types.Int64   `tfsdk:"out_errors"`
+	InDiscards      types.Int64   `tfsdk:"in_discards"`
+	OutDiscards     types.Int64   `tfsdk:"out_discards"`
+	ErrorRate       types.Float64 `tfsdk:"error_rate"`
+	DiscardRate     types.Float64 `tfsdk:"discard_rate"`
+	ErrorsPerSecond types.Float64 `tfsdk:"errors_per_second"`
+}
+
+// interfaceCounters are the counters of an interface the error rates are computed from.
+type interfaceCounters struct {
+	packets, inErrors, outErrors, inDiscards, outDiscards uint64
+}
+
+func (d *InterfaceErrorRatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interface_error_rates"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *InterfaceErrorRatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the error and discard rates of the interfaces of F5OS based systems like chassis partitions or rSeries platforms, measured over a sampling interval.\n\n" +
+			"Use this data source in preconditions or postconditions to require healthy uplinks before and after changes, for example of LAGs. " +
+			"The counters are read twice, `sample_interval` seconds apart, so that the rates reflect the current traffic rather than the errors since the counters were last cleared.",
+
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the interfaces to measure, for example `1.0` or `lag1`, all interfaces when not set.",
+			},
+			"sample_interval": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Number of seconds between the two reads of the counters, default is `10`.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 600),
+				},
+			},
+			"threshold": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Error or discard rate above which an interface is reported in `above_threshold`, for example `0.001` for one in a thousand packets.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"max_error_rate": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Highest `error_rate` of the measured interfaces.",
+			},
+			"max_discard_rate": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Highest `discard_rate` of the measured interfaces.",
+			},
+			"above_threshold": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the interfaces whose error or discard rate is above `threshold`, empty when `threshold` is not set.",
+			},
+			"interfaces": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of measured interfaces, with the counter increases over the sampling interval.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the interface.",
+						},
+						"oper_status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Operational status of the interface, for example `UP` or `DOWN`.",
+						},
+						"packets": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Packets received and sent by the interface.",
+						},
+						"in_errors": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Received packets with errors.",
+						},
+						"out_errors": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Packets that could not be sent because of errors.",
+						},
+						"in_discards": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Received packets discarded without errors, for example for lack of buffer space.",
+						},
+						"out_discards": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Packets to send discarded without errors.",
+						},
+						"error_rate": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Errors per packet, `0` when the interface had no traffic.",
+						},
+						"discard_rate": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Discards per packet, `0` when the interface had no traffic.",
+						},
+						"errors_per_second": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Errors per second.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InterfaceErrorRatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *InterfaceErrorRatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InterfaceErrorRatesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client.PlatformType == "Velos Controller" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_interface_error_rates` data source is supported with Velos Partition level/rSeries appliance.")
+		return
+	}
+	interval := 10 * time.Second
+	if !data.SampleInterval.IsNull() {
+		interval = time.Duration(data.SampleInterval.ValueInt64()) * time.Second
+	}
+	first, _, _, err := d.readCounters()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Get Interfaces", fmt.Sprintf("Error:%s", err))
+		return
+	}
+	select {
+	case <-ctx.Done():
+		resp.Diagnostics.AddError("Interface Sampling Cancelled", ctx.Err().Error())
+		return
+	case <-time.After(interval):
+	}
+	second, operStatus, intfNames, err := d.readCounters()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Get Interfaces", fmt.Sprintf("Error:%s", err))
+		return
+	}
+
+	names := []string{}
+	for _, name := range data.Names {
+		names = append(names, name.ValueString())
+	}
+	if data.Names == nil {
+		names = intfNames
+	}
+	data.Interfaces = []InterfaceErrorRateModel{}
+	data.AboveThreshold = []types.String{}
+	maxErrorRate, maxDiscardRate := 0.0, 0.0
+	for _, name := range names {
+		after, ok := second[name]
+		if !ok {
+			resp.Diagnostics.AddError("Unknown Interface", fmt.Sprintf("Interface %s does not exist on the system.", name))
+			return
+		}
+		before, ok := first[name]
+		if !ok {
+			before = after
+		}
+		delta := after.since(before)
+		errors := delta.inErrors + delta.outErrors
+		discards := delta.inDiscards + delta.outDiscards
+		errorRate, discardRate := 0.0, 0.0
+		if delta.packets > 0 {
+			errorRate = float64(errors) / float64(delta.packets)
+			discardRate = float64(discards) / float64(delta.packets)
+		}
+		maxErrorRate = max(maxErrorRate, errorRate)
+		maxDiscardRate = max(maxDiscardRate, discardRate)
+		if !data.Threshold.IsNull() && (errorRate > data.Threshold.ValueFloat64() || discardRate > data.Threshold.ValueFloat64()) {
+			data.AboveThreshold = append(data.AboveThreshold, types.StringValue(name))
+		}
+		data.Interfaces = append(data.Interfaces, InterfaceErrorRateModel{
+			Name:            types.StringValue(name),
+			OperStatus:      types.StringValue(operStatus[name]),
+			Packets:         types.Int64Value(int64(delta.packets)),
+			InErrors:        types.Int64Value(int64(delta.inErrors)),
+			OutErrors:       types.Int64Value(int64(delta.outErrors)),
+			InDiscards:      types.Int64Value(int64(delta.inDiscards)),
+			OutDiscards:     types.Int64Value(int64(delta.outDiscards)),
+			ErrorRate:       types.Float64Value(errorRate),
+			DiscardRate:     types.Float64Value(discardRate),
+			ErrorsPerSecond: types.Float64Value(float64(errors) / interval.Seconds()),
+		})
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Interface error rates :%+v", data.Interfaces))
+	data.MaxErrorRate = types.Float64Value(maxErrorRate)
+	data.MaxDiscardRate = types.Float64Value(maxDiscardRate)
+	data.ID = types.StringValue(fmt.Sprintf("%s-interface-error-rates", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readCounters returns the counters and the operational status of the interfaces, keyed by name,
+// and the names of the interfaces in the order the system reports them.
+func (d *InterfaceErrorRatesDataSource) readCounters() (map[string]interfaceCounters, map[string]string, []string, error) {
+	intfs, err := d.client.GetInterfaces()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	counters := make(map[string]interfaceCounters)
+	operStatus := make(map[string]string)
+	names := []string{}
+	for _, intf := range intfs {
+		names = append(names, intf.Name)
+		state := intf.State.Counters
+		counters[intf.Name] = interfaceCounters{
+			packets: parseCounter(state.InUnicastPkts) + parseCounter(state.InBroadcastPkts) + parseCounter(state.InMulticastPkts) +
+				parseCounter(state.OutUnicastPkts) + parseCounter(state.OutBroadcastPkts) + parseCounter(state.OutMulticastPkts),
+			inErrors:    parseCounter(state.InErrors),
+			outErrors:   parseCounter(state.OutErrors),
+			inDiscards:  parseCounter(state.InDiscards),
+			outDiscards: parseCounter(state.OutDiscards),
+		}
+		operStatus[intf.Name] = intf.State.OperStatus
+	}
+	return counters, operStatus, names, nil
+}
+
+// since returns the increase of the counters from before, a counter cleared in between
+// increased by its current value.
+func (c interfaceCounters) since(before interfaceCounters) interfaceCounters {
+	increase := func(after, before uint64) uint64 {
+		if after < before {
+			return after
+		}
+		return after - before
+	}
+	return interfaceCounters{
+		packets:     increase(c.packets, before.packets),
+		inErrors:    increase(c.inErrors, before.inErrors),
+		outErrors:   increase(c.outErrors, before.outErrors),
+		inDiscards:  increase(c.inDiscards, before.inDiscards),
+		outDiscards: increase(c.outDiscards, before.outDiscards),
+	}
+}
+
+// parseCounter parses a 64-bit counter, which RESTCONF reports as a string, 0 when not reported.
+func parseCounter(value string) uint64 {
+	counter, _ := strconv.ParseUint(value, 10, 64)
+	return counter
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccInterfaceErrorRatesDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInterfaceErrorRatesDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_interface_error_rates.test", "max_error_rate"),
+					resource.TestCheckResourceAttrSet("data.f5os_interface_error_rates.test", "interfaces.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInterfaceErrorRatesDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/rseries_platform_state_ok.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-image:image/state/install", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/rseries_platform_version.json"))
+	})
+	// Every read samples the counters twice, the second sample after the interval
+	samples := 0
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		samples++
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString(fmt.Sprintf("./fixtures/interface_counters_sample_%d.json", 2-samples%2)))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInterfaceErrorRatesDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "interfaces.#", "3"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "interfaces.0.name", "1.0"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "interfaces.0.oper_status", "UP"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "interfaces.0.packets", "8000"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "interfaces.0.in_errors", "8"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "interfaces.0.in_discards", "0"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "interfaces.0.error_rate", "0.001"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "interfaces.0.errors_per_second", "8"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "interfaces.1.packets", "0"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "interfaces.1.error_rate", "0"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "interfaces.2.name", "lag1"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "interfaces.2.packets", "2000"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "interfaces.2.in_errors", "0"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "interfaces.2.discard_rate", "0.01"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "max_error_rate", "0.001"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "max_discard_rate", "0.01"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "above_threshold.#", "1"),
+					resource.TestCheckResourceAttr("data.f5os_interface_error_rates.test", "above_threshold.0", "lag1"),
+				),
+			},
+			{
+				Config:      testAccInterfaceErrorRatesDatasourceUnknownConfig,
+				ExpectError: regexp.MustCompile("Interface 9.0 does not exist on the system"),
+			},
+		},
+	})
+}
+
+const testAccInterfaceErrorRatesDatasourceConfig = `
+data "f5os_interface_error_rates" "test" {
+  sample_interval = 1
+  threshold       = 0.005
+}
+`
+
+const testAccInterfaceErrorRatesDatasourceUnknownConfig = `
+data "f5os_interface_error_rates" "test" {
+  names           = ["1.0", "9.0"]
+  sample_interval = 1
+}
+`
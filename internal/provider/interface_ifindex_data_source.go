@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &InterfaceIfindexDataSource{}
+)
+
+func NewInterfaceIfindexDataSource() datasource.DataSource {
+	return &InterfaceIfindexDataSource{}
+}
+
+// InterfaceIfindexDataSource defines the data source implementation.
+type InterfaceIfindexDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// InterfaceIfindexDataSourceModel describes the data source data model.
+type InterfaceIfindexDataSourceModel struct {
+	ID         types.String            `tfsdk:"id"`
+	Names      []types.String          `tfsdk:"names"`
+	Ifindexes  types.Map               `tfsdk:"ifindexes"`
+	Interfaces []InterfaceIfindexModel `tfsdk:"interfaces"`
+}
+
+type InterfaceIfindexModel struct {
+	Name    types.String `tfsdk:"name"`
+	Ifindex types.Int64  `tfsdk:"ifindex"`
+	Type    types.String `tfsdk:"type"`
+}
+
+func (d *InterfaceIfindexDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interface_ifindex"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *InterfaceIfindexDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the SNMP `ifIndex` assigned to the interfaces of F5OS based systems like chassis partitions or rSeries platforms.\n\n" +
+			"Use this data source to wire SNMP based monitoring of the ports configured in the same run.",
+
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only report these interfaces, for example `1.0` or `lag1`.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"ifindexes": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "SNMP `ifIndex` of every reported interface, keyed by interface name.",
+			},
+			"interfaces": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of reported interfaces.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the interface.",
+						},
+						"ifindex": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "SNMP `ifIndex` assigned to the interface.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the interface, for example `ethernetCsmacd` or `ieee8023adLag`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InterfaceIfindexDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *InterfaceIfindexDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InterfaceIfindexDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client.PlatformType == "Velos Controller" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_interface_ifindex` data source is supported with Velos Partition level/rSeries appliance.")
+		return
+	}
+	intfs, err := d.client.GetInterfaces()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Get Interfaces", fmt.Sprintf("Error:%s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Interfaces :%+v", intfs))
+	names := make(map[string]bool)
+	for _, name := range data.Names {
+		names[name.ValueString()] = true
+	}
+	ifindexes := make(map[string]int64)
+	data.Interfaces = []InterfaceIfindexModel{}
+	for _, intf := range intfs {
+		if len(names) > 0 && !names[intf.Name] {
+			continue
+		}
+		delete(names, intf.Name)
+		intfType := intf.State.Type
+		if intfType == "" {
+			intfType = intf.Config.Type
+		}
+		ifindexes[intf.Name] = int64(intf.State.Ifindex)
+		data.Interfaces = append(data.Interfaces, InterfaceIfindexModel{
+			Name:    types.StringValue(intf.Name),
+			Ifindex: types.Int64Value(int64(intf.State.Ifindex)),
+			Type:    types.StringValue(strings.TrimPrefix(intfType, "iana-if-type:")),
+		})
+	}
+	for name := range names {
+		resp.Diagnostics.AddError("Interface Not Found", fmt.Sprintf("Interface %s does not exist on the system", name))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ifindexMap, diags := types.MapValueFrom(ctx, types.Int64Type, ifindexes)
+	resp.Diagnostics.Append(diags...)
+	data.Ifindexes = ifindexMap
+	data.ID = types.StringValue(fmt.Sprintf("%s-interface-ifindex", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
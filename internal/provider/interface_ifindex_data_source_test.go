@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccInterfaceIfindexDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInterfaceIfindexDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_interface_ifindex.test", "ifindexes.1.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInterfaceIfindexDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/interfaces_ifindex.json"))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInterfaceIfindexDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_interface_ifindex.test", "interfaces.#", "3"),
+					resource.TestCheckResourceAttr("data.f5os_interface_ifindex.test", "interfaces.2.name", "lag1"),
+					resource.TestCheckResourceAttr("data.f5os_interface_ifindex.test", "interfaces.2.type", "ieee8023adLag"),
+					resource.TestCheckResourceAttr("data.f5os_interface_ifindex.test", "ifindexes.1.0", "33554440"),
+					resource.TestCheckResourceAttr("data.f5os_interface_ifindex.test", "ifindexes.lag1", "67108865"),
+				),
+			},
+			{
+				Config: testAccInterfaceIfindexNamesDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_interface_ifindex.test", "interfaces.#", "1"),
+					resource.TestCheckResourceAttr("data.f5os_interface_ifindex.test", "ifindexes.2.0", "33554448"),
+				),
+			},
+			{
+				Config:      testAccInterfaceIfindexMissingDatasourceConfig,
+				ExpectError: regexp.MustCompile("Interface 9.0 does not exist on the system"),
+			},
+		},
+	})
+}
+
+const testAccInterfaceIfindexDatasourceConfig = `
+data "f5os_interface_ifindex" "test" {}
+`
+
+const testAccInterfaceIfindexNamesDatasourceConfig = `
+data "f5os_interface_ifindex" "test" {
+  names = ["2.0"]
+}
+`
+
+const testAccInterfaceIfindexMissingDatasourceConfig = `
+data "f5os_interface_ifindex" "test" {
+  names = ["9.0"]
+}
+`
@@ -3,13 +3,18 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
@@ -19,6 +24,9 @@ import (
 var _ resource.Resource = &InterfaceResource{}
 var _ resource.ResourceWithImportState = &InterfaceResource{}
 
+// defaultOperUpTimeout is the default number of seconds interfaces are waited for to be up.
+const defaultOperUpTimeout = 120
+
 func NewInterfaceResource() resource.Resource {
 	return &InterfaceResource{}
 }
@@ -35,6 +43,8 @@ type InterfaceResourceModel struct {
 	TrunkVlans types.Set    `tfsdk:"trunk_vlans"`
 	Enabled    types.Bool   `tfsdk:"enabled"`
 	Status     types.String `tfsdk:"status"`
+	WaitOperUp types.Bool   `tfsdk:"wait_for_oper_up"`
+	UpTimeout  types.Int64  `tfsdk:"oper_up_timeout"`
 	Id         types.String `tfsdk:"id"`
 }
 
@@ -71,6 +81,21 @@ func (r *InterfaceResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: "Operational state of the interface.",
 				Computed:            true,
 			},
+			"wait_for_oper_up": schema.BoolAttribute{
+				MarkdownDescription: "Wait, once the interface is created or updated, for its operational state to be `UP`, so that resources depending on it, such as tenants using its VLANs, are not configured on a port without link, default is `false`.\nThe wait is skipped when `enabled` is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"oper_up_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Number of seconds `wait_for_oper_up` waits for the interface to be operationally up, default is `120`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultOperUpTimeout),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Unique identifier for Interface resource.",
@@ -122,6 +147,9 @@ func (r *InterfaceResource) Create(ctx context.Context, req resource.CreateReque
 	if err != nil {
 		resp.Diagnostics.AddError("Teem Error", fmt.Sprintf("Sending Teem Data failed: %s", err))
 	}
+	if data.Enabled.ValueBool() {
+		waitForOperUp(ctx, r.client, data.Name.ValueString(), data.WaitOperUp, data.UpTimeout, &resp.Diagnostics)
+	}
 	intfData, err := r.client.GetInterface(data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Interface, got error: %s", err))
@@ -147,6 +175,11 @@ func (r *InterfaceResource) Read(ctx context.Context, req resource.ReadRequest,
 	tflog.Info(ctx, fmt.Sprintf("[READ] Reading Interface :%+v", data.Id.ValueString()))
 
 	intfData, err := r.client.GetInterface(data.Id.ValueString())
+	if isNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Interface %s no longer on the device, removing it from state", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Interface, got error: %s", err))
 		return
@@ -182,6 +215,9 @@ func (r *InterfaceResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 	tflog.Info(ctx, fmt.Sprintf("interfaceReqConfig Response:%+v", string(respByte)))
 	data.Id = types.StringValue(data.Name.ValueString())
+	if data.Enabled.ValueBool() {
+		waitForOperUp(ctx, r.client, data.Name.ValueString(), data.WaitOperUp, data.UpTimeout, &resp.Diagnostics)
+	}
 	intfData, err := r.client.GetInterface(data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Interface, got error: %s", err))
@@ -222,6 +258,20 @@ func (r *InterfaceResource) Delete(ctx context.Context, req resource.DeleteReque
 
 func (r *InterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_oper_up"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("oper_up_timeout"), defaultOperUpTimeout)...)
+}
+
+// waitForOperUp waits, when wait is set, for the interface or LAG name to be operationally up
+// for at most timeout seconds.
+func waitForOperUp(ctx context.Context, client *f5ossdk.F5os, name string, wait types.Bool, timeout types.Int64, diags *diag.Diagnostics) {
+	if !wait.ValueBool() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Waiting for interface %s to be operationally up", name))
+	if err := client.WaitInterfaceOperUp(name, time.Duration(timeout.ValueInt64())*time.Second); err != nil {
+		diags.AddError("F5OS Client Error:", fmt.Sprintf("Interface %s is not operationally up, got error: %s", name, err))
+	}
 }
 
 func (r *InterfaceResource) interfaceResourceModelToState(ctx context.Context, respData *f5ossdk.F5RespOpenconfigInterface, data *InterfaceResourceModel) {
@@ -2,8 +2,12 @@ package provider
 
 import (
 	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"path"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -110,6 +114,10 @@ func TestAccInterfaceCreateUnitTC3Resource(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 		_, _ = fmt.Fprintf(w, "%s", "")
 	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces/interface=1.0/config/type", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", `{"openconfig-interfaces:type": "iana-if-type:ethernetCsmacd"}`)
+	})
 	defer teardown()
 	resource.Test(t, resource.TestCase{
 		IsUnitTest:               true,
@@ -172,6 +180,10 @@ func TestAccInterfaceCreateUnitTC4Resource(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 		_, _ = fmt.Fprintf(w, "%s", "")
 	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces/interface=1.0/config/type", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", `{"openconfig-interfaces:type": "iana-if-type:ethernetCsmacd"}`)
+	})
 	defer teardown()
 	resource.Test(t, resource.TestCase{
 		IsUnitTest:               true,
@@ -194,6 +206,204 @@ func TestAccInterfaceCreateUnitTC4Resource(t *testing.T) {
 	})
 }
 
+func TestAccInterfaceCreateUnitTC5Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprintf(w, "%s", `{"ietf-restconf:errors": {"error": [`+
+			`{"error-type": "application", "error-tag": "invalid-value", "error-path": "/openconfig-interfaces:interfaces/interface[name='1.0']/openconfig-if-ethernet:ethernet/openconfig-vlan:switched-vlan/config/native-vlan", "error-message": "illegal reference"},`+
+			`{"error-type": "application", "error-tag": "invalid-value", "error-path": "/openconfig-interfaces:interfaces/interface[name='1.0']/openconfig-if-ethernet:ethernet/openconfig-vlan:switched-vlan/config/trunk-vlans", "error-message": "illegal reference"}]}}`)
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces/interface=1.0/openconfig-if-ethernet:ethernet/openconfig-vlan:switched-vlan", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces/interface=1.0/config/type", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", `{"openconfig-interfaces:type": "iana-if-type:ethernetCsmacd"}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInterfaceCreateunitResourceConfig,
+				ExpectError: regexp.MustCompile(`(?s)native-vlan.*trunk-vlans.*400 Bad Request`),
+			},
+		},
+	})
+}
+
+func TestAccInterfaceCreateUnitTC6Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	// the interface disappears from the device, e.g. a breakout port re-cabled, once created
+	removed := false
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces/interface=1.0", func(w http.ResponseWriter, r *http.Request) {
+		if removed {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprintf(w, "%s", `{"ietf-restconf:errors": {"error": [{"error-type": "application", "error-tag": "invalid-value", "error-message": "uri keypath not found"}]}}`)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/interface_get_r5k_status.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces/interface=1.0/openconfig-if-ethernet:ethernet/openconfig-vlan:switched-vlan", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces/interface=1.0/config/type", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", `{"openconfig-interfaces:type": "iana-if-type:ethernetCsmacd"}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInterfaceCreateunitResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_interface.test_interface", "native_vlan", "13"),
+				),
+			},
+			{
+				PreConfig:          func() { removed = true },
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccInterfaceCreateUnitTC7Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprintf(w, "%s", `{"ietf-restconf:errors": {"error": [{"error-type": "application", "error-tag": "invalid-value", "error-message": "illegal reference"}]}}`)
+	})
+	// the vlans the modified config drops are removed before the failing PATCH, then restored
+	var removedVlans []string
+	var restored string
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces/interface=1.0/openconfig-if-ethernet:ethernet/openconfig-vlan:switched-vlan", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			body, _ := io.ReadAll(r.Body)
+			restored = string(body)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", `{"openconfig-vlan:switched-vlan": {"config": {"native-vlan": 13, "trunk-vlans": [10, 11, 12]}}}`)
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces/interface=1.0/openconfig-if-ethernet:ethernet/openconfig-vlan:switched-vlan/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		removedVlans = append(removedVlans, path.Base(r.URL.Path))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces/interface=1.0/config/type", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", `{"openconfig-interfaces:type": "iana-if-type:ethernetCsmacd"}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInterfaceCreateunitmodifyResourceConfig,
+				ExpectError: regexp.MustCompile(`illegal reference`),
+			},
+		},
+	})
+	assert.Equal(t, []string{"openconfig-vlan:native-vlan", "openconfig-vlan:trunk-vlans=12"}, removedVlans)
+	assert.JSONEq(t, `{"openconfig-vlan:switched-vlan": {"config": {"native-vlan": 13, "trunk-vlans": [10, 11, 12]}}}`, restored)
+}
+
+func TestAccInterfaceCreateUnitTC8Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", "")
+	})
+	// the port is still down on the first read after the apply, up on the next ones
+	reads := 0
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces/interface=1.0", func(w http.ResponseWriter, r *http.Request) {
+		reads++
+		w.WriteHeader(http.StatusOK)
+		intf := loadFixtureString("./fixtures/interface_get_r5k_status.json")
+		if reads == 1 {
+			intf = strings.Replace(intf, `"oper-status": "UP"`, `"oper-status": "DOWN"`, 1)
+		}
+		_, _ = fmt.Fprintf(w, "%s", intf)
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces/interface=1.0/openconfig-if-ethernet:ethernet/openconfig-vlan:switched-vlan", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", "")
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces/interface=1.0/config/type", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", `{"openconfig-interfaces:type": "iana-if-type:ethernetCsmacd"}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInterfaceCreateunitWaitResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_interface.test_interface", "wait_for_oper_up", "true"),
+					resource.TestCheckResourceAttr("f5os_interface.test_interface", "status", "UP"),
+				),
+			},
+		},
+	})
+	assert.GreaterOrEqual(t, reads, 2)
+}
+
 const testAccInterfaceCreateunitResourceConfig = `
 resource "f5os_interface" "test_interface" {
   enabled     = true
@@ -210,6 +420,16 @@ resource "f5os_interface" "test_interface" {
   trunk_vlans = [10,11,13]
 }`
 
+const testAccInterfaceCreateunitWaitResourceConfig = `
+resource "f5os_interface" "test_interface" {
+  enabled          = true
+  name             = "1.0"
+  native_vlan      = 13
+  trunk_vlans      = [10,11,12]
+  wait_for_oper_up = true
+  oper_up_timeout  = 30
+}`
+
 const testAccInterfaceCreateResourceConfig = `
 resource "f5os_vlan" "vlan10" {
  vlan_id = 10
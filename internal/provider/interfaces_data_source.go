@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &InterfacesDataSource{}
+)
+
+func NewInterfacesDataSource() datasource.DataSource {
+	return &InterfacesDataSource{}
+}
+
+// InterfacesDataSource defines the data source implementation.
+type InterfacesDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// InterfacesDataSourceModel describes the data source data model.
+type InterfacesDataSourceModel struct {
+	ID         types.String     `tfsdk:"id"`
+	Type       types.String     `tfsdk:"type"`
+	Names      []types.String   `tfsdk:"names"`
+	Interfaces []InterfaceModel `tfsdk:"interfaces"`
+}
+
+type InterfaceModel struct {
+	Name        types.String  `tfsdk:"name"`
+	Description types.String  `tfsdk:"description"`
+	Type        types.String  `tfsdk:"type"`
+	Enabled     types.Bool    `tfsdk:"enabled"`
+	OperStatus  types.String  `tfsdk:"oper_status"`
+	Mtu         types.Int64   `tfsdk:"mtu"`
+	Speed       types.String  `tfsdk:"speed"`
+	Lag         types.String  `tfsdk:"lag"`
+	NativeVlan  types.Int64   `tfsdk:"native_vlan"`
+	TrunkVlans  []types.Int64 `tfsdk:"trunk_vlans"`
+}
+
+func (d *InterfacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interfaces"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *InterfacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the interfaces of F5OS based systems like chassis partitions or rSeries platforms, with their operational status, speed and VLAN membership.\n\n" +
+			"Use this data source to iterate over the ports of a system without hardcoding their names.",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only report interfaces of this type, `ethernetCsmacd` for the physical ports or `ieee8023adLag` for the LAGs.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("ethernetCsmacd", "ieee8023adLag"),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"names": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the reported interfaces.",
+			},
+			"interfaces": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of reported interfaces.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the interface, for example `1.0` or `lag1`.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Description of the interface.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the interface, for example `ethernetCsmacd` or `ieee8023adLag`.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the interface is administratively enabled.",
+						},
+						"oper_status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Operational status of the interface, for example `UP` or `DOWN`.",
+						},
+						"mtu": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "MTU of the interface.",
+						},
+						"speed": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Speed of the physical port, for example `SPEED_100GB`, empty for LAGs.",
+						},
+						"lag": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "LAG the physical port is a member of, empty when it is not.",
+						},
+						"native_vlan": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Native VLAN of the interface, null when it has none.",
+						},
+						"trunk_vlans": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.Int64Type,
+							MarkdownDescription: "Trunk VLANs of the interface.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InterfacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *InterfacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InterfacesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client.PlatformType == "Velos Controller" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_interfaces` data source is supported with Velos Partition level/rSeries appliance.")
+		return
+	}
+	data.Names = []types.String{}
+	data.Interfaces = []InterfaceModel{}
+	// the interfaces of multi-blade systems are read in pages
+	err := d.client.EachInterface(nil, func(intf f5ossdk.F5RespInterface) error {
+		intfType := intf.State.Type
+		if intfType == "" {
+			intfType = intf.Config.Type
+		}
+		intfType = strings.TrimPrefix(intfType, "iana-if-type:")
+		if !data.Type.IsNull() && intfType != data.Type.ValueString() {
+			return nil
+		}
+		data.Names = append(data.Names, types.StringValue(intf.Name))
+		data.Interfaces = append(data.Interfaces, interfaceModelFromResp(intf, intfType))
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Get Interfaces", fmt.Sprintf("Error:%s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Interfaces :%+v", data.Names))
+	data.ID = types.StringValue(fmt.Sprintf("%s-interfaces", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func interfaceModelFromResp(intf f5ossdk.F5RespInterface, intfType string) InterfaceModel {
+	ethernet := intf.OpenconfigIfEthernetEthernet
+	switchedVlan := ethernet.OpenconfigVlanSwitchedVlan.Config
+	speed := ""
+	if intfType == "ieee8023adLag" {
+		switchedVlan = intf.OpenconfigIfAggregateAggregation.OpenconfigVlanSwitchedVlan.Config
+	} else {
+		for _, portSpeed := range []string{ethernet.State.NegotiatedPortSpeed, ethernet.State.PortSpeed, ethernet.Config.PortSpeed} {
+			if portSpeed != "" {
+				speed = strings.TrimPrefix(portSpeed, "openconfig-if-ethernet:")
+				break
+			}
+		}
+	}
+	model := InterfaceModel{
+		Name:        types.StringValue(intf.Name),
+		Description: types.StringValue(intf.Config.Description),
+		Type:        types.StringValue(intfType),
+		Enabled:     types.BoolValue(intf.Config.Enabled),
+		OperStatus:  types.StringValue(intf.State.OperStatus),
+		Mtu:         types.Int64Value(int64(intf.State.Mtu)),
+		Speed:       types.StringValue(speed),
+		Lag:         types.StringValue(ethernet.Config.AggregateId),
+		NativeVlan:  types.Int64Null(),
+		TrunkVlans:  []types.Int64{},
+	}
+	if switchedVlan.NativeVlan != 0 {
+		model.NativeVlan = types.Int64Value(int64(switchedVlan.NativeVlan))
+	}
+	for _, vlan := range switchedVlan.TrunkVlans {
+		model.TrunkVlans = append(model.TrunkVlans, types.Int64Value(int64(vlan)))
+	}
+	return model
+}
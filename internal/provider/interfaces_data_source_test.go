@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccInterfacesDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInterfacesDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_interfaces.test", "names.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInterfacesDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	var inventory struct {
+		Interfaces struct {
+			Interface []json.RawMessage `json:"interface"`
+		} `json:"openconfig-interfaces:interfaces"`
+	}
+	assert.NoError(t, json.Unmarshal(loadFixtureBytes("./fixtures/interfaces_inventory.json"), &inventory))
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces/interface", testAccPagedListHandler("openconfig-interfaces:interface", inventory.Interfaces.Interface))
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInterfacesDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_interfaces.test", "names.#", "3"),
+					resource.TestCheckResourceAttr("data.f5os_interfaces.test", "interfaces.0.name", "1.0"),
+					resource.TestCheckResourceAttr("data.f5os_interfaces.test", "interfaces.0.oper_status", "UP"),
+					resource.TestCheckResourceAttr("data.f5os_interfaces.test", "interfaces.0.speed", "SPEED_100GB"),
+					resource.TestCheckResourceAttr("data.f5os_interfaces.test", "interfaces.0.lag", "lag1"),
+					resource.TestCheckNoResourceAttr("data.f5os_interfaces.test", "interfaces.0.native_vlan"),
+					resource.TestCheckResourceAttr("data.f5os_interfaces.test", "interfaces.1.speed", "SPEED_25GB"),
+					resource.TestCheckResourceAttr("data.f5os_interfaces.test", "interfaces.1.native_vlan", "100"),
+					resource.TestCheckResourceAttr("data.f5os_interfaces.test", "interfaces.1.trunk_vlans.#", "2"),
+					resource.TestCheckResourceAttr("data.f5os_interfaces.test", "interfaces.2.type", "ieee8023adLag"),
+					resource.TestCheckResourceAttr("data.f5os_interfaces.test", "interfaces.2.trunk_vlans.0", "400"),
+				),
+			},
+			{
+				Config: testAccInterfacesPhysicalDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_interfaces.test", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.f5os_interfaces.test", "names.1", "2.0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccInterfacesDatasourceConfig = `
+data "f5os_interfaces" "test" {}
+`
+
+const testAccInterfacesPhysicalDatasourceConfig = `
+data "f5os_interfaces" "test" {
+  type = "ethernetCsmacd"
+}
+`
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Labels are stored in the description of the tenants, F5OS having no metadata fields, following
+// the description text as `[key=value,key=value]` with the keys sorted, for example
+// `web frontend [env=prod,team=web]`.
+var (
+	labelKeyRegexp   = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+	labelValueRegexp = regexp.MustCompile(`^[A-Za-z0-9_.:/-]*$`)
+)
+
+// encodeLabels returns the description storing text and labels.
+func encodeLabels(text string, labels map[string]string) string {
+	if len(labels) == 0 {
+		return text
+	}
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+labels[key])
+	}
+	encoded := "[" + strings.Join(pairs, ",") + "]"
+	if text == "" {
+		return encoded
+	}
+	return text + " " + encoded
+}
+
+// decodeLabels returns the text and labels of a description written by encodeLabels. Descriptions
+// not ending with valid labels are only text.
+func decodeLabels(description string) (string, map[string]string) {
+	if !strings.HasSuffix(description, "]") {
+		return description, nil
+	}
+	start := strings.LastIndex(description, "[")
+	if start < 0 || (start > 0 && description[start-1] != ' ') {
+		return description, nil
+	}
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(description[start+1:len(description)-1], ",") {
+		key, value, found := strings.Cut(pair, "=")
+		if !found || !labelKeyRegexp.MatchString(key) || !labelValueRegexp.MatchString(value) {
+			return description, nil
+		}
+		labels[key] = value
+	}
+	return strings.TrimSuffix(description[:start], " "), labels
+}
+
+// matchLabels reports whether labels has every label of selector.
+func matchLabels(labels, selector map[string]string) bool {
+	for key, value := range selector {
+		if labelValue, ok := labels[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// validateLabels reports the labels of the attribute name that cannot be stored in a description.
+func validateLabels(ctx context.Context, labels types.Map, name string, diags *diag.Diagnostics) {
+	if labels.IsNull() || labels.IsUnknown() {
+		return
+	}
+	elements := make(map[string]types.String)
+	diags.Append(labels.ElementsAs(ctx, &elements, false)...)
+	for key, value := range elements {
+		if !labelKeyRegexp.MatchString(key) {
+			diags.AddAttributeError(path.Root(name).AtMapKey(key), "Invalid Label",
+				fmt.Sprintf("Label key %q must start with a letter or digit and contain only letters, digits, `_`, `.` and `-`.", key))
+		}
+		if !value.IsUnknown() && !value.IsNull() && !labelValueRegexp.MatchString(value.ValueString()) {
+			diags.AddAttributeError(path.Root(name).AtMapKey(key), "Invalid Label",
+				fmt.Sprintf("Label value %q must contain only letters, digits, `_`, `.`, `:`, `/` and `-`.", value.ValueString()))
+		}
+	}
+}
+
+// labelsMap returns the labels configured in the attribute labels.
+func labelsMap(ctx context.Context, labels types.Map, diags *diag.Diagnostics) map[string]string {
+	values := make(map[string]string)
+	diags.Append(labels.ElementsAs(ctx, &values, false)...)
+	return values
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitLabels(t *testing.T) {
+	description := encodeLabels("Web frontend", map[string]string{"team": "web", "env": "prod"})
+	assert.Equal(t, "Web frontend [env=prod,team=web]", description)
+	text, labels := decodeLabels(description)
+	assert.Equal(t, "Web frontend", text)
+	assert.Equal(t, map[string]string{"env": "prod", "team": "web"}, labels)
+
+	assert.Equal(t, "[cost-center=cc:42]", encodeLabels("", map[string]string{"cost-center": "cc:42"}))
+	text, labels = decodeLabels("[cost-center=cc:42]")
+	assert.Equal(t, "", text)
+	assert.Equal(t, map[string]string{"cost-center": "cc:42"}, labels)
+
+	// descriptions written by hand are kept as text
+	for _, description := range []string{"Web frontend", "see [runbook]", "a [b=c d]", "x[team=web]", "[]"} {
+		text, labels = decodeLabels(description)
+		assert.Equal(t, description, text)
+		assert.Nil(t, labels)
+	}
+
+	assert.True(t, matchLabels(map[string]string{"env": "prod", "team": "web"}, map[string]string{"team": "web"}))
+	assert.True(t, matchLabels(nil, nil))
+	assert.False(t, matchLabels(map[string]string{"team": "web"}, map[string]string{"team": "db"}))
+	assert.False(t, matchLabels(nil, map[string]string{"team": "web"}))
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -38,6 +41,8 @@ type LagResourceModel struct {
 	Id         types.String `tfsdk:"id"`
 	Mode       types.String `tfsdk:"mode"`
 	Interval   types.String `tfsdk:"interval"`
+	WaitOperUp types.Bool   `tfsdk:"wait_for_oper_up"`
+	UpTimeout  types.Int64  `tfsdk:"oper_up_timeout"`
 }
 
 func (r *LagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -99,6 +104,21 @@ func (r *LagResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 					stringvalidator.OneOf([]string{"SLOW", "FAST"}...),
 				},
 			},
+			"wait_for_oper_up": schema.BoolAttribute{
+				MarkdownDescription: "Wait, once the LAG interface is created or updated, for its operational state to be `UP`, i.e. for a member link to be established, default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"oper_up_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Number of seconds `wait_for_oper_up` waits for the LAG interface to be operationally up, default is `120`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultOperUpTimeout),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -137,6 +157,7 @@ func (r *LagResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	tflog.Debug(ctx, fmt.Sprintf("lagInterfaceReqConfig Response:%+v", string(respByte)))
 	data.Id = types.StringValue(data.Name.ValueString())
+	waitForOperUp(ctx, r.client, data.Name.ValueString(), data.WaitOperUp, data.UpTimeout, &resp.Diagnostics)
 
 	intfData, err := r.client.GetLagInterface(data.Name.ValueString())
 	if err != nil {
@@ -171,6 +192,11 @@ func (r *LagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	tflog.Info(ctx, fmt.Sprintf("[READ] Reading LAG interface :%+v", data.Id.ValueString()))
 
 	intfData, err := r.client.GetLagInterface(data.Id.ValueString())
+	if isNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("LAG interface %s no longer on the device, removing it from state", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get LAG interface, got error: %s", err))
 		return
@@ -211,28 +237,12 @@ func (r *LagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	tflog.Info(ctx, fmt.Sprintf("modeIntervalConfig Data:%+v", modeIntervalConfig))
 
 	if !data.Members.IsNull() && !data.Members.IsUnknown() {
-		memberData, err := r.client.GetLagInterface(data.Id.ValueString())
+		membersConfig := getLagMembersConfig(ctx, data)
+		_, err := r.client.ReconcileLagMembers(data.Id.ValueString(), membersConfig)
 		if err != nil {
-			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read LAG interface members got error: %s", err))
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to update LAG interface members, got error: %s", err))
 			return
 		}
-		if memberData != nil {
-			var haveMembers []string
-			for _, member := range memberData.OpenconfigInterfacesInterface[0].OpenconfigIfAggregateAggregation.State.Members.Member {
-				haveMembers = append(haveMembers, member.Name)
-			}
-			err := r.client.RemoveLagMembers(haveMembers)
-			if err != nil {
-				resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove members from LAG interface, got error: %s", err))
-				return
-			}
-			membersConfig := getLagMembersConfig(ctx, data)
-			_, err = r.client.UpdateLagMembers(membersConfig)
-			if err != nil {
-				resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to update LAG interface members, got error: %s", err))
-				return
-			}
-		}
 	}
 
 	respByte, err := r.client.UpdateLagInterface(data.Id.ValueString(), lagInterfaceReqConfig, modeIntervalConfig)
@@ -243,6 +253,7 @@ func (r *LagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	tflog.Info(ctx, fmt.Sprintf("lagInterfaceReqConfig Response:%+v", string(respByte)))
 
 	data.Id = types.StringValue(data.Name.ValueString())
+	waitForOperUp(ctx, r.client, data.Name.ValueString(), data.WaitOperUp, data.UpTimeout, &resp.Diagnostics)
 
 	intfData, err := r.client.GetLagInterface(data.Name.ValueString())
 	if err != nil {
@@ -279,7 +290,7 @@ func (r *LagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 	// Remove any associated interfaces
-	if memberData != nil {
+	if memberData != nil && len(memberData.OpenconfigInterfacesInterface) > 0 {
 		var haveMembers []string
 		for _, member := range memberData.OpenconfigInterfacesInterface[0].OpenconfigIfAggregateAggregation.State.Members.Member {
 			haveMembers = append(haveMembers, member.Name)
@@ -299,7 +310,7 @@ func (r *LagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 	err3 := r.client.RemoveLagInterface(data.Id.ValueString())
 	if err3 != nil {
-		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to delete LAG interface, got error: %s", err2))
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to delete LAG interface, got error: %s", err3))
 		return
 	}
 
@@ -307,6 +318,8 @@ func (r *LagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 func (r *LagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_oper_up"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("oper_up_timeout"), defaultOperUpTimeout)...)
 }
 
 func (r *LagResource) lagInterfaceResourceModelToState(ctx context.Context, respData *f5ossdk.F5RespLagInterfaces, lacpData *f5ossdk.LacpInterfaceResponses, data *LagResourceModel) {
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+const (
+	// providerLogName is the name Terraform gives to the logs of the provider.
+	providerLogName = "f5os"
+	// defaultLogLevelKey sets the level of the types not listed in log_levels.
+	defaultLogLevelKey = "default"
+)
+
+var logLevelNames = []string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR", "OFF"}
+
+// logLevels holds the log level of the types configured in `log_levels`.
+type logLevels struct {
+	levels atomic.Pointer[map[string]hclog.Level]
+}
+
+// logLevelContext returns ctx with a provider logger at the level configured for typeName, ctx
+// itself when no level is configured for it.
+func (p *F5osProvider) logLevelContext(ctx context.Context, typeName string) context.Context {
+	levels := p.logLevels.levels.Load()
+	if levels == nil {
+		return ctx
+	}
+	level, ok := (*levels)[typeName]
+	if !ok {
+		level, ok = (*levels)[defaultLogLevelKey]
+	}
+	if !ok {
+		return ctx
+	}
+	return tfsdklog.NewRootProviderLogger(ctx, tfsdklog.WithStderrFromInit(), tfsdklog.WithLogName(providerLogName), tflog.WithLevel(level))
+}
+
+// setLogLevels checks and applies the levels of `log_levels`, keyed by resource or data source
+// type names.
+func (p *F5osProvider) setLogLevels(ctx context.Context, levelNames map[string]string, diags *diag.Diagnostics) {
+	if len(levelNames) == 0 {
+		return
+	}
+	known := map[string]bool{defaultLogLevelKey: true}
+	for _, typeName := range p.typeNames(ctx) {
+		known[strings.TrimPrefix(typeName, "data.")] = true
+	}
+	levels := make(map[string]hclog.Level, len(levelNames))
+	var unknown []string
+	for typeName, levelName := range levelNames {
+		if !known[typeName] {
+			unknown = append(unknown, typeName)
+			continue
+		}
+		level := hclog.LevelFromString(levelName)
+		if level == hclog.NoLevel {
+			diags.AddError(
+				"Invalid 'log_levels' in provider configuration",
+				fmt.Sprintf("log level of %s must be one of %s, got %q.", typeName, strings.Join(logLevelNames, ", "), levelName),
+			)
+			continue
+		}
+		levels[typeName] = level
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		diags.AddError(
+			"Invalid 'log_levels' in provider configuration",
+			fmt.Sprintf("log_levels sets the level of unknown resource or data source types: %s.", strings.Join(unknown, ", ")),
+		)
+	}
+	if diags.HasError() {
+		return
+	}
+	p.logLevels.levels.Store(&levels)
+}
+
+// parseLogLevels parses levels set as comma separated `<type>=<level>` pairs.
+func parseLogLevels(value string) map[string]string {
+	levels := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		if typeName, level, ok := strings.Cut(strings.TrimSpace(pair), "="); ok {
+			levels[strings.TrimSpace(typeName)] = strings.TrimSpace(level)
+		}
+	}
+	return levels
+}
@@ -0,0 +1,584 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LoggingResource{}
+var _ resource.ResourceWithValidateConfig = &LoggingResource{}
+var _ resource.ResourceWithImportState = &LoggingResource{}
+
+var loggingSelectorType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"facility": types.StringType,
+	"severity": types.StringType,
+}}
+
+var loggingServerType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"address":        types.StringType,
+	"port":           types.Int64Type,
+	"protocol":       types.StringType,
+	"authentication": types.BoolType,
+	"logs":           types.ListType{ElemType: loggingSelectorType},
+}}
+
+var loggingFacilities = []string{"local0", "authpriv"}
+
+var loggingSeverities = []string{"debug", "informational", "notice", "warning", "error", "critical", "alert", "emergency"}
+
+func NewLoggingResource() resource.Resource {
+	return &LoggingResource{}
+}
+
+// LoggingResource defines the resource implementation.
+type LoggingResource struct {
+	client *f5ossdk.F5os
+}
+
+// LoggingResourceModel describes the resource data model.
+type LoggingResourceModel struct {
+	Servers   types.List       `tfsdk:"servers"`
+	Tls       *LoggingTlsModel `tfsdk:"tls"`
+	CaBundles types.List       `tfsdk:"ca_bundles"`
+	Scope     types.String     `tfsdk:"scope"`
+	Id        types.String     `tfsdk:"id"`
+}
+
+// LoggingServerModel describes an entry of the remote syslog servers list.
+type LoggingServerModel struct {
+	Address        types.String `tfsdk:"address"`
+	Port           types.Int64  `tfsdk:"port"`
+	Protocol       types.String `tfsdk:"protocol"`
+	Authentication types.Bool   `tfsdk:"authentication"`
+	Logs           types.List   `tfsdk:"logs"`
+}
+
+// LoggingSelectorModel describes the logs forwarded to a remote syslog server.
+type LoggingSelectorModel struct {
+	Facility types.String `tfsdk:"facility"`
+	Severity types.String `tfsdk:"severity"`
+}
+
+// LoggingTlsModel describes the certificate authenticating the system to the remote syslog servers.
+type LoggingTlsModel struct {
+	Certificate types.String `tfsdk:"certificate"`
+	Key         types.String `tfsdk:"key"`
+}
+
+// LoggingCaBundleModel describes a CA bundle verifying the certificates of the remote syslog servers.
+type LoggingCaBundleModel struct {
+	Name    types.String `tfsdk:"name"`
+	Content types.String `tfsdk:"content"`
+}
+
+func (r *LoggingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_logging"
+}
+
+func (r *LoggingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource used to forward the logs of F5OS systems to remote syslog servers.\n\n" +
+			"Logs are forwarded with TLS to the servers with `authentication` enabled, which requires the `tls` certificate of the system and is supported over `tcp` only. " +
+			"Updates only send the servers, certificate and CA bundles that are new or changed, the dropped ones being removed after them so that no logs are lost. " +
+			"The servers, TLS certificate and CA bundles are removed from the device when the resource is destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"servers": schema.ListNestedAttribute{
+				MarkdownDescription: "Remote syslog servers the logs are forwarded to.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							MarkdownDescription: "Hostname, IPv4 or IPv6 address of the remote syslog server.",
+							Required:            true,
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "Port of the remote syslog server, default is `514`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(514),
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol the logs are sent with, `udp` (default) or `tcp`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("udp"),
+							Validators: []validator.String{
+								stringvalidator.OneOf([]string{"udp", "tcp"}...),
+							},
+						},
+						"authentication": schema.BoolAttribute{
+							MarkdownDescription: "Whether the logs are forwarded with TLS, authenticating the system with the `tls` certificate, default is `false`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"logs": schema.ListNestedAttribute{
+							MarkdownDescription: "Logs forwarded to the server, by facility and lowest severity.",
+							Required:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"facility": schema.StringAttribute{
+										MarkdownDescription: "Facility of the logs, `local0` or `authpriv`.",
+										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(loggingFacilities...),
+										},
+									},
+									"severity": schema.StringAttribute{
+										MarkdownDescription: "Lowest severity of the logs forwarded, one of `debug`, `informational`, `notice`, `warning`, `error`, `critical`, `alert` or `emergency`.",
+										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(loggingSeverities...),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tls": schema.SingleNestedAttribute{
+				MarkdownDescription: "Certificate and key authenticating the system to the remote syslog servers with `authentication` enabled.\nThe device does not return the key, changes made outside of Terraform are not detected.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"certificate": schema.StringAttribute{
+						MarkdownDescription: "PEM encoded certificate of the system.",
+						Required:            true,
+					},
+					"key": schema.StringAttribute{
+						MarkdownDescription: "PEM encoded private key of the certificate.",
+						Required:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"ca_bundles": schema.ListNestedAttribute{
+				MarkdownDescription: "CA bundles verifying the certificates of the remote syslog servers.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the CA bundle.",
+							Required:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "PEM encoded CA certificates.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"scope": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Level of the system the configuration applies to, `controller` or `partition` on VELOS chassis and `appliance` on rSeries, following the address the provider connects to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *LoggingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *LoggingResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Servers.IsUnknown() {
+		return
+	}
+	var servers []LoggingServerModel
+	resp.Diagnostics.Append(data.Servers.ElementsAs(ctx, &servers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, server := range servers {
+		if !server.Authentication.ValueBool() {
+			continue
+		}
+		if !server.Protocol.IsUnknown() && server.Protocol.ValueString() != "tcp" {
+			resp.Diagnostics.AddAttributeError(path.Root("servers"), "Invalid Remote Syslog Server", fmt.Sprintf("`authentication` of remote syslog server %s requires `protocol` `tcp`", server.Address.ValueString()))
+		}
+		if data.Tls == nil {
+			resp.Diagnostics.AddAttributeError(path.Root("tls"), "Missing TLS Certificate", fmt.Sprintf("`authentication` of remote syslog server %s requires the `tls` certificate of the system", server.Address.ValueString()))
+		}
+	}
+}
+
+func (r *LoggingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *LoggingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *LoggingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loggingConfig := getLoggingConfig(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] Remote syslog servers :%+v", loggingConfig.Logging.RemoteServers))
+	if err := r.client.SetLogging(loggingConfig); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to configure logging, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s-logging", r.client.Host))
+	data.Scope = types.StringValue(r.client.ServiceScope())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LoggingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *LoggingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if serviceScopeMoved(ctx, r.client, &data.Scope, "Logging") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	logging, err := r.client.GetLogging()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get logging, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Remote syslog servers :%+v", logging.Logging.RemoteServers))
+	loggingResourceModelToState(ctx, logging, data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LoggingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *LoggingResourceModel
+	var state *LoggingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, servers := loggingServers(ctx, data, &resp.Diagnostics)
+	haveAddresses, haveServers := loggingServers(ctx, state, &resp.Diagnostics)
+	var serverList, haveServerList []LoggingServerModel
+	var caBundles, haveCaBundles []LoggingCaBundleModel
+	resp.Diagnostics.Append(data.Servers.ElementsAs(ctx, &serverList, false)...)
+	resp.Diagnostics.Append(state.Servers.ElementsAs(ctx, &haveServerList, false)...)
+	resp.Diagnostics.Append(data.CaBundles.ElementsAs(ctx, &caBundles, false)...)
+	resp.Diagnostics.Append(state.CaBundles.ElementsAs(ctx, &haveCaBundles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// only the new and changed servers, certificate and CA bundles are sent, they are added before
+	// the dropped ones are removed so that the logs keep being forwarded
+	var tls *LoggingTlsModel
+	if data.Tls != nil && (state.Tls == nil || *data.Tls != *state.Tls) {
+		tls = data.Tls
+	}
+	changedServers := changedLoggingServers(haveServerList, serverList)
+	changedCaBundles := changedLoggingCaBundles(haveCaBundles, caBundles)
+	if tls != nil || len(changedServers) > 0 || len(changedCaBundles) > 0 {
+		loggingConfig := loggingRequest(ctx, changedServers, tls, changedCaBundles, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tflog.Info(ctx, fmt.Sprintf("[UPDATE] Remote syslog servers :%+v", loggingConfig.Logging.RemoteServers))
+		if err := r.client.SetLogging(loggingConfig); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to configure logging, got error: %s", err))
+			return
+		}
+	}
+	// the PATCH merges the selectors of the servers kept, the ones dropped are removed after it
+	for _, address := range haveAddresses {
+		logs, ok := servers[address]
+		if !ok {
+			if err := r.client.RemoveLogRemoteServer(address); err != nil {
+				resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove remote syslog server %s, got error: %s", address, err))
+				return
+			}
+			continue
+		}
+		for _, selector := range stringListDifference(haveServers[address], logs) {
+			facility, severity, _ := strings.Cut(selector, ",")
+			if err := r.client.RemoveLogSelector(address, facility, severity); err != nil {
+				resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove the logs forwarded to remote syslog server %s, got error: %s", address, err))
+				return
+			}
+		}
+	}
+	for _, name := range stringListDifference(loggingCaBundleNames(haveCaBundles), loggingCaBundleNames(caBundles)) {
+		if err := r.client.RemoveLogCaBundle(name); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove CA bundle %s, got error: %s", name, err))
+			return
+		}
+	}
+	if data.Tls == nil && state.Tls != nil {
+		if err := r.client.RemoveLogTls(); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove the logging TLS certificate, got error: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LoggingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *LoggingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addresses, _ := loggingServers(ctx, data, &resp.Diagnostics)
+	var caBundles []LoggingCaBundleModel
+	resp.Diagnostics.Append(data.CaBundles.ElementsAs(ctx, &caBundles, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, address := range addresses {
+		tflog.Info(ctx, fmt.Sprintf("[DELETE] Removing remote syslog server :%+v", address))
+		if err := r.client.RemoveLogRemoteServer(address); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove remote syslog server %s, got error: %s", address, err))
+			return
+		}
+	}
+	if data.Tls != nil {
+		if err := r.client.RemoveLogTls(); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove the logging TLS certificate, got error: %s", err))
+			return
+		}
+	}
+	for _, name := range loggingCaBundleNames(caBundles) {
+		if err := r.client.RemoveLogCaBundle(name); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove CA bundle %s, got error: %s", name, err))
+			return
+		}
+	}
+}
+
+func (r *LoggingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s-logging", r.client.Host))...)
+}
+
+func getLoggingConfig(ctx context.Context, data *LoggingResourceModel, diags *diag.Diagnostics) *f5ossdk.F5ReqLogging {
+	var servers []LoggingServerModel
+	var caBundles []LoggingCaBundleModel
+	diags.Append(data.Servers.ElementsAs(ctx, &servers, false)...)
+	diags.Append(data.CaBundles.ElementsAs(ctx, &caBundles, false)...)
+	return loggingRequest(ctx, servers, data.Tls, caBundles, diags)
+}
+
+// loggingRequest returns the request merging servers, tls, when set, and caBundles into the
+// logging configuration of the system.
+func loggingRequest(ctx context.Context, servers []LoggingServerModel, tls *LoggingTlsModel, caBundles []LoggingCaBundleModel, diags *diag.Diagnostics) *f5ossdk.F5ReqLogging {
+	loggingConfig := &f5ossdk.F5ReqLogging{}
+	if len(servers) > 0 {
+		loggingConfig.Logging.RemoteServers = &f5ossdk.F5LogRemoteServers{}
+	}
+	for _, server := range servers {
+		var logs []LoggingSelectorModel
+		diags.Append(server.Logs.ElementsAs(ctx, &logs, false)...)
+		remoteServer := f5ossdk.F5LogRemoteServer{
+			Host: server.Address.ValueString(),
+			Config: f5ossdk.F5LogRemoteServerConfig{
+				Host:       server.Address.ValueString(),
+				RemotePort: int(server.Port.ValueInt64()),
+				Proto:      server.Protocol.ValueString(),
+			},
+			Selectors:      &f5ossdk.F5LogSelectors{},
+			Authentication: &f5ossdk.F5LogAuthentication{},
+		}
+		remoteServer.Authentication.Config.Enabled = server.Authentication.ValueBool()
+		for _, log := range logs {
+			facility := loggingFacilityIdentity(log.Facility.ValueString())
+			severity := strings.ToUpper(log.Severity.ValueString())
+			remoteServer.Selectors.Selector = append(remoteServer.Selectors.Selector, f5ossdk.F5LogSelector{
+				Facility: facility,
+				Severity: severity,
+				Config:   f5ossdk.F5LogSelectorConfig{Facility: facility, Severity: severity},
+			})
+		}
+		loggingConfig.Logging.RemoteServers.RemoteServer = append(loggingConfig.Logging.RemoteServers.RemoteServer, remoteServer)
+	}
+	if tls != nil {
+		loggingConfig.Logging.Tls = &f5ossdk.F5LogTls{
+			Certificate: tls.Certificate.ValueString(),
+			Key:         tls.Key.ValueString(),
+		}
+	}
+	if len(caBundles) > 0 {
+		loggingConfig.Logging.CaBundles = &f5ossdk.F5LogCaBundles{}
+	}
+	for _, caBundle := range caBundles {
+		bundle := f5ossdk.F5LogCaBundle{Name: caBundle.Name.ValueString()}
+		bundle.Config.Name = caBundle.Name.ValueString()
+		bundle.Config.Content = caBundle.Content.ValueString()
+		loggingConfig.Logging.CaBundles.CaBundle = append(loggingConfig.Logging.CaBundles.CaBundle, bundle)
+	}
+	return loggingConfig
+}
+
+// loggingResourceModelToState refreshes the remote syslog servers, the TLS certificate and
+// CA bundles are kept from the state as the device does not return their secrets.
+func loggingResourceModelToState(ctx context.Context, logging *f5ossdk.F5ReqLogging, data *LoggingResourceModel, diags *diag.Diagnostics) {
+	servers := []LoggingServerModel{}
+	if logging.Logging.RemoteServers != nil {
+		for _, remoteServer := range logging.Logging.RemoteServers.RemoteServer {
+			logs := []LoggingSelectorModel{}
+			if remoteServer.Selectors != nil {
+				for _, selector := range remoteServer.Selectors.Selector {
+					logs = append(logs, LoggingSelectorModel{
+						Facility: types.StringValue(loggingFacilityName(selector.Config.Facility)),
+						Severity: types.StringValue(strings.ToLower(selector.Config.Severity)),
+					})
+				}
+			}
+			logList, d := types.ListValueFrom(ctx, loggingSelectorType, logs)
+			diags.Append(d...)
+			protocol := remoteServer.Config.Proto
+			if protocol == "" {
+				protocol = "udp"
+			}
+			port := int64(remoteServer.Config.RemotePort)
+			if port == 0 {
+				port = 514
+			}
+			servers = append(servers, LoggingServerModel{
+				Address:        types.StringValue(remoteServer.Host),
+				Port:           types.Int64Value(port),
+				Protocol:       types.StringValue(protocol),
+				Authentication: types.BoolValue(remoteServer.Authentication != nil && remoteServer.Authentication.Config.Enabled),
+				Logs:           logList,
+			})
+		}
+	}
+	// the servers are not ordered on the system, they are kept in the order of the state
+	haveAddresses, _ := loggingServers(ctx, data, diags)
+	position := listPositions(haveAddresses)
+	sort.SliceStable(servers, func(i, j int) bool {
+		return position(servers[i].Address.ValueString()) < position(servers[j].Address.ValueString())
+	})
+	var d diag.Diagnostics
+	data.Servers, d = types.ListValueFrom(ctx, loggingServerType, servers)
+	diags.Append(d...)
+}
+
+// changedLoggingServers returns the remote syslog servers that are not in have, or are
+// configured differently.
+func changedLoggingServers(have, servers []LoggingServerModel) []LoggingServerModel {
+	haveServers := make(map[string]LoggingServerModel, len(have))
+	for _, server := range have {
+		haveServers[server.Address.ValueString()] = server
+	}
+	var changed []LoggingServerModel
+	for _, server := range servers {
+		haveServer, ok := haveServers[server.Address.ValueString()]
+		if !ok || !haveServer.Port.Equal(server.Port) || !haveServer.Protocol.Equal(server.Protocol) ||
+			!haveServer.Authentication.Equal(server.Authentication) || !haveServer.Logs.Equal(server.Logs) {
+			changed = append(changed, server)
+		}
+	}
+	return changed
+}
+
+// changedLoggingCaBundles returns the CA bundles that are not in have, or have another content.
+func changedLoggingCaBundles(have, caBundles []LoggingCaBundleModel) []LoggingCaBundleModel {
+	haveContents := make(map[string]types.String, len(have))
+	for _, caBundle := range have {
+		haveContents[caBundle.Name.ValueString()] = caBundle.Content
+	}
+	var changed []LoggingCaBundleModel
+	for _, caBundle := range caBundles {
+		if content, ok := haveContents[caBundle.Name.ValueString()]; !ok || !content.Equal(caBundle.Content) {
+			changed = append(changed, caBundle)
+		}
+	}
+	return changed
+}
+
+// loggingServers returns the addresses of the remote syslog servers, in order, and maps each
+// address to the "facility,severity" keys of its selectors.
+func loggingServers(ctx context.Context, data *LoggingResourceModel, diags *diag.Diagnostics) ([]string, map[string][]string) {
+	var servers []LoggingServerModel
+	diags.Append(data.Servers.ElementsAs(ctx, &servers, false)...)
+	var addresses []string
+	selectors := make(map[string][]string)
+	for _, server := range servers {
+		var logs []LoggingSelectorModel
+		diags.Append(server.Logs.ElementsAs(ctx, &logs, false)...)
+		keys := []string{}
+		for _, log := range logs {
+			keys = append(keys, fmt.Sprintf("%s,%s", loggingFacilityIdentity(log.Facility.ValueString()), strings.ToUpper(log.Severity.ValueString())))
+		}
+		addresses = append(addresses, server.Address.ValueString())
+		selectors[server.Address.ValueString()] = keys
+	}
+	return addresses, selectors
+}
+
+func loggingFacilityIdentity(facility string) string {
+	return fmt.Sprintf("f5-system-logging-types:%s", strings.ToUpper(facility))
+}
+
+func loggingFacilityName(identity string) string {
+	_, facility, found := strings.Cut(identity, ":")
+	if !found {
+		facility = identity
+	}
+	return strings.ToLower(facility)
+}
+
+func loggingCaBundleNames(caBundles []LoggingCaBundleModel) []string {
+	var names []string
+	for _, caBundle := range caBundles {
+		names = append(names, caBundle.Name.ValueString())
+	}
+	return names
+}
@@ -0,0 +1,608 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NtpResource{}
+var _ resource.ResourceWithValidateConfig = &NtpResource{}
+var _ resource.ResourceWithModifyPlan = &NtpResource{}
+var _ resource.ResourceWithImportState = &NtpResource{}
+
+var ntpServerType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"address": types.StringType,
+	"key_id":  types.Int64Type,
+	"prefer":  types.BoolType,
+	"iburst":  types.BoolType,
+}}
+
+var ntpKeyType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":    types.Int64Type,
+	"type":  types.StringType,
+	"value": types.StringType,
+}}
+
+// ntpKeyTypes maps the supported NTP key types to their YANG identity.
+var ntpKeyTypes = map[string]string{
+	"md5":    "openconfig-system:NTP_AUTH_MD5",
+	"sha1":   "f5-openconfig-system-ntp:NTP_AUTH_SHA1",
+	"sha256": "f5-openconfig-system-ntp:NTP_AUTH_SHA2_256",
+}
+
+func NewNtpResource() resource.Resource {
+	return &NtpResource{}
+}
+
+// NtpResource defines the resource implementation.
+type NtpResource struct {
+	client *f5ossdk.F5os
+}
+
+// NtpResourceModel describes the resource data model.
+type NtpResourceModel struct {
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	Authentication       types.Bool   `tfsdk:"authentication"`
+	Servers              types.List   `tfsdk:"servers"`
+	Keys                 types.List   `tfsdk:"keys"`
+	KeysFingerprint      types.String `tfsdk:"keys_fingerprint"`
+	MatchKeysFingerprint types.String `tfsdk:"match_keys_fingerprint"`
+	Scope                types.String `tfsdk:"scope"`
+	Id                   types.String `tfsdk:"id"`
+}
+
+// NtpServerModel describes an entry of the NTP servers list.
+type NtpServerModel struct {
+	Address types.String `tfsdk:"address"`
+	KeyId   types.Int64  `tfsdk:"key_id"`
+	Prefer  types.Bool   `tfsdk:"prefer"`
+	Iburst  types.Bool   `tfsdk:"iburst"`
+}
+
+// NtpKeyModel describes an entry of the NTP authentication keys list.
+type NtpKeyModel struct {
+	Id    types.Int64  `tfsdk:"id"`
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (r *NtpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ntp"
+}
+
+func (r *NtpResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource used to manage NTP time synchronization of F5OS systems, including NTP authentication keys.\n\n" +
+			"The resource manages all NTP servers and keys of the system. " +
+			"Updates only send the servers and keys that are new or changed, and remove the dropped ones once the new ones are configured, so that the clock keeps being synchronized. " +
+			"The servers and keys are removed from the device, and NTP authentication is disabled, when the resource is destroyed.\n\n" +
+			"On VELOS chassis, set `match_keys_fingerprint` of the partition resources to the `keys_fingerprint` of the controller one, so that keys that differ from the controller keys fail the plan instead of silently breaking the time synchronization of the partitions.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the system synchronizes its clock with the NTP servers, default is `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"authentication": schema.BoolAttribute{
+				MarkdownDescription: "Whether NTP authentication is enabled, default is `false`.\nThe servers then need a `key_id` of one of the `keys`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"servers": schema.ListNestedAttribute{
+				MarkdownDescription: "NTP servers the system synchronizes with.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							MarkdownDescription: "Hostname, IPv4 or IPv6 address of the NTP server.",
+							Required:            true,
+						},
+						"key_id": schema.Int64Attribute{
+							MarkdownDescription: "Identifier of the key in `keys` authenticating the server.",
+							Optional:            true,
+						},
+						"prefer": schema.BoolAttribute{
+							MarkdownDescription: "Whether the server is preferred over the other servers, default is `false`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"iburst": schema.BoolAttribute{
+							MarkdownDescription: "Whether a burst of packets is sent when the server is unreachable, to speed up the initial synchronization, default is `false`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"keys": schema.ListNestedAttribute{
+				MarkdownDescription: "NTP authentication keys.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Identifier of the key, shared with the NTP server.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the key, `md5` (default), `sha1` or `sha256`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("md5"),
+							Validators: []validator.String{
+								stringvalidator.OneOf([]string{"md5", "sha1", "sha256"}...),
+							},
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Secret of the key. The device does not return it, changes made outside of Terraform are not detected.",
+							Required:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
+			"keys_fingerprint": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "SHA-256 fingerprint of the IDs, types and values of the `keys`, whatever their order. Systems sharing the same keys have the same fingerprint.",
+			},
+			"match_keys_fingerprint": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Fingerprint the `keys` must have, such as the `keys_fingerprint` of the NTP resource of the VELOS controller for the resource of a partition. Keys that do not match fail the plan, or the apply when the fingerprint is only known then, before the system is changed.",
+			},
+			"scope": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Level of the system the configuration applies to, `controller` or `partition` on VELOS chassis and `appliance` on rSeries, following the address the provider connects to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *NtpResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *NtpResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Servers.IsUnknown() || data.Keys.IsUnknown() {
+		return
+	}
+	var servers []NtpServerModel
+	var keys []NtpKeyModel
+	resp.Diagnostics.Append(data.Servers.ElementsAs(ctx, &servers, false)...)
+	resp.Diagnostics.Append(data.Keys.ElementsAs(ctx, &keys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keyIds := make(map[int64]bool)
+	for _, key := range keys {
+		if key.Id.IsUnknown() {
+			return
+		}
+		if keyIds[key.Id.ValueInt64()] {
+			resp.Diagnostics.AddAttributeError(path.Root("keys"), "Invalid NTP Keys", fmt.Sprintf("NTP key %d is configured more than once", key.Id.ValueInt64()))
+		}
+		keyIds[key.Id.ValueInt64()] = true
+	}
+	for _, server := range servers {
+		if server.KeyId.IsNull() || server.KeyId.IsUnknown() {
+			continue
+		}
+		if !keyIds[server.KeyId.ValueInt64()] {
+			resp.Diagnostics.AddAttributeError(path.Root("servers"), "Invalid NTP Server Key", fmt.Sprintf("`key_id` %d of NTP server %s is not one of the `keys`", server.KeyId.ValueInt64(), server.Address.ValueString()))
+		}
+	}
+	if data.Authentication.ValueBool() && len(keys) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("authentication"), "Invalid NTP Authentication", "`authentication` requires at least one of the `keys`")
+	}
+}
+
+func (r *NtpResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var data *NtpResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.KeysFingerprint = planNtpKeysFingerprint(ctx, data.Keys, &resp.Diagnostics)
+	checkNtpKeysMatch(data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("keys_fingerprint"), data.KeysFingerprint)...)
+}
+
+func (r *NtpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *NtpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *NtpResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.KeysFingerprint = planNtpKeysFingerprint(ctx, data.Keys, &resp.Diagnostics)
+	checkNtpKeysMatch(data, &resp.Diagnostics)
+	ntpConfig := getNtpConfig(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] NTP servers :%+v", ntpConfig.Ntp.Servers))
+	if err := r.client.SetNtp(ntpConfig); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to configure NTP, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s-ntp", r.client.Host))
+	data.Scope = types.StringValue(r.client.ServiceScope())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NtpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *NtpResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if serviceScopeMoved(ctx, r.client, &data.Scope, "NTP") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ntp, err := r.client.GetNtp()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get NTP, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("NTP servers :%+v", ntp.Ntp.Servers))
+	r.ntpResourceModelToState(ctx, ntp, data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NtpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *NtpResourceModel
+	var state *NtpResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var servers, haveServers []NtpServerModel
+	var keys, haveKeys []NtpKeyModel
+	resp.Diagnostics.Append(data.Servers.ElementsAs(ctx, &servers, false)...)
+	resp.Diagnostics.Append(data.Keys.ElementsAs(ctx, &keys, false)...)
+	resp.Diagnostics.Append(state.Servers.ElementsAs(ctx, &haveServers, false)...)
+	resp.Diagnostics.Append(state.Keys.ElementsAs(ctx, &haveKeys, false)...)
+	data.KeysFingerprint = types.StringValue(ntpKeysFingerprint(keys))
+	checkNtpKeysMatch(data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// only the new and changed settings, servers and keys are sent, they are added before the
+	// dropped ones are removed so that the system keeps synchronizing its clock
+	var ntpConfig *f5ossdk.F5NtpConfig
+	if !data.Enabled.Equal(state.Enabled) || !data.Authentication.Equal(state.Authentication) {
+		ntpConfig = &f5ossdk.F5NtpConfig{Enabled: data.Enabled.ValueBool(), EnableNtpAuth: data.Authentication.ValueBool()}
+	}
+	changedServers := changedNtpServers(haveServers, servers)
+	changedKeys := changedNtpKeys(haveKeys, keys)
+	if ntpConfig != nil || len(changedServers) > 0 || len(changedKeys) > 0 {
+		ntpReq := ntpRequest(ntpConfig, changedServers, changedKeys)
+		tflog.Info(ctx, fmt.Sprintf("[UPDATE] NTP servers :%+v", ntpReq.Ntp.Servers))
+		if err := r.client.SetNtp(ntpReq); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to configure NTP, got error: %s", err))
+			return
+		}
+	}
+	// servers go first, a removed key may still be referenced by a removed server
+	for _, address := range stringListDifference(ntpServerAddresses(haveServers), ntpServerAddresses(servers)) {
+		if err := r.client.RemoveNtpServer(address); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove NTP server %s, got error: %s", address, err))
+			return
+		}
+	}
+	keyIds := make(map[int64]bool)
+	for _, key := range keys {
+		keyIds[key.Id.ValueInt64()] = true
+	}
+	for _, key := range haveKeys {
+		if keyIds[key.Id.ValueInt64()] {
+			continue
+		}
+		if err := r.client.RemoveNtpKey(int(key.Id.ValueInt64())); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove NTP key %d, got error: %s", key.Id.ValueInt64(), err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NtpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *NtpResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var servers []NtpServerModel
+	var keys []NtpKeyModel
+	resp.Diagnostics.Append(data.Servers.ElementsAs(ctx, &servers, false)...)
+	resp.Diagnostics.Append(data.Keys.ElementsAs(ctx, &keys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[DELETE] Removing NTP servers %+v", ntpServerAddresses(servers)))
+	if data.Authentication.ValueBool() {
+		ntpConfig := &f5ossdk.F5ReqNtp{}
+		ntpConfig.Ntp.Config = &f5ossdk.F5NtpConfig{Enabled: data.Enabled.ValueBool(), EnableNtpAuth: false}
+		if err := r.client.SetNtp(ntpConfig); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to disable NTP authentication, got error: %s", err))
+			return
+		}
+	}
+	for _, address := range ntpServerAddresses(servers) {
+		if err := r.client.RemoveNtpServer(address); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove NTP server %s, got error: %s", address, err))
+			return
+		}
+	}
+	for _, key := range keys {
+		if err := r.client.RemoveNtpKey(int(key.Id.ValueInt64())); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove NTP key %d, got error: %s", key.Id.ValueInt64(), err))
+			return
+		}
+	}
+}
+
+func (r *NtpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s-ntp", r.client.Host))...)
+}
+
+func getNtpConfig(ctx context.Context, data *NtpResourceModel, diags *diag.Diagnostics) *f5ossdk.F5ReqNtp {
+	var servers []NtpServerModel
+	var keys []NtpKeyModel
+	diags.Append(data.Servers.ElementsAs(ctx, &servers, false)...)
+	diags.Append(data.Keys.ElementsAs(ctx, &keys, false)...)
+	ntpConfig := &f5ossdk.F5NtpConfig{
+		Enabled:       data.Enabled.ValueBool(),
+		EnableNtpAuth: data.Authentication.ValueBool(),
+	}
+	return ntpRequest(ntpConfig, servers, keys)
+}
+
+// ntpRequest returns the request merging config, when set, servers and keys into the NTP
+// configuration of the system.
+func ntpRequest(config *f5ossdk.F5NtpConfig, servers []NtpServerModel, keys []NtpKeyModel) *f5ossdk.F5ReqNtp {
+	ntpReq := &f5ossdk.F5ReqNtp{}
+	ntpReq.Ntp.Config = config
+	if len(keys) > 0 {
+		ntpReq.Ntp.NtpKeys = &f5ossdk.F5NtpKeys{}
+	}
+	for _, key := range keys {
+		keyId := int(key.Id.ValueInt64())
+		ntpReq.Ntp.NtpKeys.NtpKey = append(ntpReq.Ntp.NtpKeys.NtpKey, f5ossdk.F5NtpKey{
+			KeyId: keyId,
+			Config: f5ossdk.F5NtpKeyConfig{
+				KeyId:    keyId,
+				KeyType:  ntpKeyTypes[key.Type.ValueString()],
+				KeyValue: key.Value.ValueString(),
+			},
+		})
+	}
+	if len(servers) > 0 {
+		ntpReq.Ntp.Servers = &f5ossdk.F5NtpServers{}
+	}
+	for _, server := range servers {
+		ntpReq.Ntp.Servers.Server = append(ntpReq.Ntp.Servers.Server, f5ossdk.F5NtpServer{
+			Address: server.Address.ValueString(),
+			Config: f5ossdk.F5NtpServerConfig{
+				Address: server.Address.ValueString(),
+				KeyId:   int(server.KeyId.ValueInt64()),
+				Prefer:  server.Prefer.ValueBool(),
+				Iburst:  server.Iburst.ValueBool(),
+			},
+		})
+	}
+	return ntpReq
+}
+
+// changedNtpServers returns the servers that are not in have, or are configured differently.
+func changedNtpServers(have, servers []NtpServerModel) []NtpServerModel {
+	haveServers := make(map[string]NtpServerModel, len(have))
+	for _, server := range have {
+		haveServers[server.Address.ValueString()] = server
+	}
+	var changed []NtpServerModel
+	for _, server := range servers {
+		if haveServer, ok := haveServers[server.Address.ValueString()]; !ok || haveServer != server {
+			changed = append(changed, server)
+		}
+	}
+	return changed
+}
+
+// changedNtpKeys returns the keys that are not in have, or are configured differently.
+func changedNtpKeys(have, keys []NtpKeyModel) []NtpKeyModel {
+	haveKeys := make(map[int64]NtpKeyModel, len(have))
+	for _, key := range have {
+		haveKeys[key.Id.ValueInt64()] = key
+	}
+	var changed []NtpKeyModel
+	for _, key := range keys {
+		if haveKey, ok := haveKeys[key.Id.ValueInt64()]; !ok || haveKey != key {
+			changed = append(changed, key)
+		}
+	}
+	return changed
+}
+
+func (r *NtpResource) ntpResourceModelToState(ctx context.Context, ntp *f5ossdk.F5ReqNtp, data *NtpResourceModel, diags *diag.Diagnostics) {
+	if ntp.Ntp.Config != nil {
+		data.Enabled = types.BoolValue(ntp.Ntp.Config.Enabled)
+		data.Authentication = types.BoolValue(ntp.Ntp.Config.EnableNtpAuth)
+	}
+	servers := []NtpServerModel{}
+	if ntp.Ntp.Servers != nil {
+		for _, server := range ntp.Ntp.Servers.Server {
+			keyId := types.Int64Null()
+			if server.Config.KeyId != 0 {
+				keyId = types.Int64Value(int64(server.Config.KeyId))
+			}
+			servers = append(servers, NtpServerModel{
+				Address: types.StringValue(server.Address),
+				KeyId:   keyId,
+				Prefer:  types.BoolValue(server.Config.Prefer),
+				Iburst:  types.BoolValue(server.Config.Iburst),
+			})
+		}
+	}
+	// the servers are not ordered on the system, they are kept in the order of the state
+	var haveServers []NtpServerModel
+	diags.Append(data.Servers.ElementsAs(ctx, &haveServers, false)...)
+	position := listPositions(ntpServerAddresses(haveServers))
+	sort.SliceStable(servers, func(i, j int) bool {
+		return position(servers[i].Address.ValueString()) < position(servers[j].Address.ValueString())
+	})
+	var d diag.Diagnostics
+	data.Servers, d = types.ListValueFrom(ctx, ntpServerType, servers)
+	diags.Append(d...)
+
+	// the device does not return the key values, they are kept from the state
+	var haveKeys []NtpKeyModel
+	diags.Append(data.Keys.ElementsAs(ctx, &haveKeys, false)...)
+	values := make(map[int64]types.String)
+	for _, key := range haveKeys {
+		values[key.Id.ValueInt64()] = key.Value
+	}
+	keys := []NtpKeyModel{}
+	if ntp.Ntp.NtpKeys != nil {
+		for _, key := range ntp.Ntp.NtpKeys.NtpKey {
+			value, ok := values[int64(key.KeyId)]
+			if !ok {
+				value = types.StringValue("")
+			}
+			keys = append(keys, NtpKeyModel{
+				Id:    types.Int64Value(int64(key.KeyId)),
+				Type:  types.StringValue(ntpKeyTypeName(key.Config.KeyType)),
+				Value: value,
+			})
+		}
+	}
+	if len(keys) > 0 || !data.Keys.IsNull() {
+		data.Keys, d = types.ListValueFrom(ctx, ntpKeyType, keys)
+		diags.Append(d...)
+	}
+	data.KeysFingerprint = types.StringValue(ntpKeysFingerprint(keys))
+}
+
+// ntpKeysFingerprint returns the SHA-256 of the IDs, types and values of keys, sorted by ID.
+func ntpKeysFingerprint(keys []NtpKeyModel) string {
+	entries := make([]string, 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, fmt.Sprintf("%d:%s:%s\n", key.Id.ValueInt64(), key.Type.ValueString(), key.Value.ValueString()))
+	}
+	sort.Strings(entries)
+	sum := sha256.Sum256([]byte(strings.Join(entries, "")))
+	return hex.EncodeToString(sum[:])
+}
+
+// planNtpKeysFingerprint returns the fingerprint of the planned keys, unknown until they are all
+// known.
+func planNtpKeysFingerprint(ctx context.Context, list types.List, diags *diag.Diagnostics) types.String {
+	if list.IsUnknown() {
+		return types.StringUnknown()
+	}
+	var keys []NtpKeyModel
+	diags.Append(list.ElementsAs(ctx, &keys, false)...)
+	for _, key := range keys {
+		if key.Id.IsUnknown() || key.Type.IsUnknown() || key.Value.IsUnknown() {
+			return types.StringUnknown()
+		}
+	}
+	return types.StringValue(ntpKeysFingerprint(keys))
+}
+
+// checkNtpKeysMatch fails when the fingerprint of the keys of data, once known, is not
+// `match_keys_fingerprint`.
+func checkNtpKeysMatch(data *NtpResourceModel, diags *diag.Diagnostics) {
+	if data.MatchKeysFingerprint.IsNull() || data.MatchKeysFingerprint.IsUnknown() || data.KeysFingerprint.IsUnknown() {
+		return
+	}
+	if data.KeysFingerprint.ValueString() != data.MatchKeysFingerprint.ValueString() {
+		diags.AddAttributeError(path.Root("match_keys_fingerprint"), "NTP Keys Mismatch",
+			"The NTP `keys` do not match the keys of `match_keys_fingerprint`, such as the keys of the VELOS controller: the IDs, types or values of some keys differ, or keys are missing. "+
+				"Use the same keys, for example by referencing the `keys` of the other NTP resource.")
+	}
+}
+
+// ntpKeyTypeName returns the key type name of the NTP key type identity keyType.
+func ntpKeyTypeName(keyType string) string {
+	for name, identity := range ntpKeyTypes {
+		if strings.HasSuffix(identity, ":"+keyType[strings.Index(keyType, ":")+1:]) {
+			return name
+		}
+	}
+	return keyType
+}
+
+func ntpServerAddresses(servers []NtpServerModel) []string {
+	var addresses []string
+	for _, server := range servers {
+		addresses = append(addresses, server.Address.ValueString())
+	}
+	return addresses
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+func TestAccNtpTC1Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNtpConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_ntp.ntp", "servers.0.address", "192.0.2.123"),
+					resource.TestCheckResourceAttr("f5os_ntp.ntp", "authentication", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNtpUnitTC1Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	ntp := &f5ossdk.F5ReqNtp{}
+	ntp.Ntp.Servers = &f5ossdk.F5NtpServers{}
+	ntp.Ntp.NtpKeys = &f5ossdk.F5NtpKeys{}
+	var removed []string
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/ntp", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			body := &f5ossdk.F5ReqNtp{}
+			_ = json.NewDecoder(r.Body).Decode(body)
+			ntp.Ntp.Config = body.Ntp.Config
+			if body.Ntp.Servers != nil {
+				ntp.Ntp.Servers = body.Ntp.Servers
+			}
+			if body.Ntp.NtpKeys != nil {
+				for _, key := range body.Ntp.NtpKeys.NtpKey {
+					assert.NotEmpty(t, key.Config.KeyValue, "Expected the value of NTP key %d", key.KeyId)
+					key.Config.KeyValue = ""
+					ntp.Ntp.NtpKeys.NtpKey = append(ntp.Ntp.NtpKeys.NtpKey, key)
+				}
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(ntp)
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/ntp/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		entry := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		removed = append(removed, entry)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			assert.Contains(t, removed, "server=192.0.2.123", "Expected the NTP server removed, got %v", removed)
+			assert.Contains(t, removed, "ntp-key=10", "Expected the NTP key removed, got %v", removed)
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNtpConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_ntp.ntp", "enabled", "true"),
+					resource.TestCheckResourceAttr("f5os_ntp.ntp", "authentication", "true"),
+					resource.TestCheckResourceAttr("f5os_ntp.ntp", "servers.0.address", "192.0.2.123"),
+					resource.TestCheckResourceAttr("f5os_ntp.ntp", "servers.0.key_id", "10"),
+					resource.TestCheckResourceAttr("f5os_ntp.ntp", "servers.0.iburst", "true"),
+					resource.TestCheckResourceAttr("f5os_ntp.ntp", "keys.0.type", "sha256"),
+					resource.TestCheckResourceAttr("f5os_ntp.ntp", "keys.0.value", "s3cr3t-ntp-key"),
+				),
+			},
+			{
+				ResourceName:            "f5os_ntp.ntp",
+				ImportState:             true,
+				ImportStateId:           "ntp",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keys.0.value", "keys_fingerprint"},
+			},
+		},
+	})
+}
+
+func TestAccNtpUnitTC2Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNtpUnknownKeyConfig,
+				ExpectError: regexp.MustCompile("is not one of the `keys`"),
+			},
+		},
+	})
+}
+
+func TestAccNtpUnitTC4Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNtpKeysMismatchConfig,
+				ExpectError: regexp.MustCompile("NTP Keys Mismatch"),
+			},
+		},
+	})
+}
+
+func TestUnitNtpKeysFingerprint(t *testing.T) {
+	key := func(id int64, keyType, value string) NtpKeyModel {
+		return NtpKeyModel{Id: types.Int64Value(id), Type: types.StringValue(keyType), Value: types.StringValue(value)}
+	}
+	controller := []NtpKeyModel{key(10, "sha256", "s3cr3t"), key(11, "md5", "other")}
+	assert.Equal(t, ntpKeysFingerprint(controller), ntpKeysFingerprint([]NtpKeyModel{controller[1], controller[0]}), "Expected the fingerprint independent of the key order")
+	assert.NotEqual(t, ntpKeysFingerprint(controller), ntpKeysFingerprint([]NtpKeyModel{key(10, "sha256", "s3cr3t"), key(11, "md5", "changed")}), "Expected another fingerprint for another key value")
+	assert.NotEqual(t, ntpKeysFingerprint(controller), ntpKeysFingerprint(controller[:1]), "Expected another fingerprint for a missing key")
+}
+
+func TestAccNtpUnitTC3Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	servers := make(map[string]f5ossdk.F5NtpServer)
+	var addresses, calls []string
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	// the device merges the servers it is sent and returns them sorted by address
+	mux.HandleFunc("/restconf/data/openconfig-system:system/ntp", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			body := &f5ossdk.F5ReqNtp{}
+			_ = json.NewDecoder(r.Body).Decode(body)
+			call := "PATCH"
+			if body.Ntp.Config != nil {
+				call += " config"
+			}
+			if body.Ntp.Servers != nil {
+				for _, server := range body.Ntp.Servers.Server {
+					call += " " + server.Address
+					servers[server.Address] = server
+					addresses = appendMissing(addresses, server.Address)
+				}
+			}
+			calls = append(calls, call)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		ntp := &f5ossdk.F5ReqNtp{}
+		ntp.Ntp.Config = &f5ossdk.F5NtpConfig{Enabled: true}
+		ntp.Ntp.Servers = &f5ossdk.F5NtpServers{}
+		sorted := append([]string(nil), addresses...)
+		sort.Strings(sorted)
+		for _, address := range sorted {
+			ntp.Ntp.Servers.Server = append(ntp.Ntp.Servers.Server, servers[address])
+		}
+		_ = json.NewEncoder(w).Encode(ntp)
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/ntp/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		address := r.URL.Path[strings.LastIndex(r.URL.Path, "=")+1:]
+		calls = append(calls, "DELETE "+address)
+		addresses = stringListDifference(addresses, []string{address})
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNtpServersConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_ntp.ntp", "servers.0.address", "time2.example.com"),
+					resource.TestCheckResourceAttr("f5os_ntp.ntp", "servers.1.address", "time1.example.com"),
+				),
+			},
+			{
+				Config: testAccNtpServersModifiedConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_ntp.ntp", "servers.#", "2"),
+					resource.TestCheckResourceAttr("f5os_ntp.ntp", "servers.0.address", "time2.example.com"),
+					resource.TestCheckResourceAttr("f5os_ntp.ntp", "servers.0.prefer", "true"),
+					resource.TestCheckResourceAttr("f5os_ntp.ntp", "servers.1.address", "time3.example.com"),
+					func(s *terraform.State) error {
+						assert.Equal(t, []string{
+							"PATCH config time2.example.com time1.example.com",
+							"PATCH time2.example.com time3.example.com",
+							"DELETE time1.example.com",
+						}, calls, "Expected the changed servers added before the dropped one is removed, got %v", calls)
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+const testAccNtpConfig = `
+resource "f5os_ntp" "ntp" {
+  authentication = true
+  servers = [
+    {
+      address = "192.0.2.123"
+      key_id  = 10
+      iburst  = true
+    }
+  ]
+  keys = [
+    {
+      id    = 10
+      type  = "sha256"
+      value = "s3cr3t-ntp-key"
+    }
+  ]
+}
+`
+
+const testAccNtpUnknownKeyConfig = `
+resource "f5os_ntp" "ntp" {
+  servers = [
+    {
+      address = "192.0.2.123"
+      key_id  = 11
+    }
+  ]
+}
+`
+
+const testAccNtpServersConfig = `
+resource "f5os_ntp" "ntp" {
+  servers = [
+    {
+      address = "time2.example.com"
+    },
+    {
+      address = "time1.example.com"
+    }
+  ]
+}
+`
+
+const testAccNtpServersModifiedConfig = `
+resource "f5os_ntp" "ntp" {
+  servers = [
+    {
+      address = "time2.example.com"
+      prefer  = true
+    },
+    {
+      address = "time3.example.com"
+    }
+  ]
+}
+`
+
+const testAccNtpKeysMismatchConfig = `
+resource "f5os_ntp" "ntp" {
+  servers = [
+    {
+      address = "192.0.2.123"
+      key_id  = 10
+    }
+  ]
+  keys = [
+    {
+      id    = 10
+      value = "partition-ntp-key"
+    }
+  ]
+  match_keys_fingerprint = "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+}
+`
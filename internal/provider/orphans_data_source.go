@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &OrphansDataSource{}
+)
+
+// orphanResourceTypes are the resource types the orphans data source scans, in the order their
+// objects are reported.
+var orphanResourceTypes = []string{"f5os_tenant", "f5os_vlan", "f5os_lag"}
+
+func NewOrphansDataSource() datasource.DataSource {
+	return &OrphansDataSource{}
+}
+
+// OrphansDataSource defines the data source implementation.
+type OrphansDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// OrphansDataSourceModel describes the data source data model.
+type OrphansDataSourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	NamePrefix    types.String   `tfsdk:"name_prefix"`
+	ResourceTypes []types.String `tfsdk:"resource_types"`
+	ManagedIDs    []types.String `tfsdk:"managed_ids"`
+	Orphans       []OrphanModel  `tfsdk:"orphans"`
+	ImportBlocks  types.String   `tfsdk:"import_blocks"`
+}
+
+type OrphanModel struct {
+	ResourceType types.String `tfsdk:"resource_type"`
+	Name         types.String `tfsdk:"name"`
+	ImportID     types.String `tfsdk:"import_id"`
+	Status       types.String `tfsdk:"status"`
+}
+
+func (d *OrphansDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orphans"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *OrphansDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Report the tenants, VLANs and LAGs of F5OS based systems like chassis partitions or rSeries platforms whose name starts with the prefix of the objects Terraform creates, but that are not among the objects of the state.\n\n" +
+			"Use this data source after an interrupted or failed apply to find the objects left half-created on the system, such as tenants that never finished deploying: remove them with the cleanup automation of your pipeline, " +
+			"or write `import_blocks` to a `.tf` file to bring them back under Terraform management.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"name_prefix": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Prefix of the names of the objects created by Terraform, for example `tf-`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"resource_types": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: fmt.Sprintf("Resource types to scan, among %s, default is all of them.", "`"+strings.Join(orphanResourceTypes, "`, `")+"`"),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(orphanResourceTypes...)),
+				},
+			},
+			"managed_ids": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the objects of the state, for example `[for t in f5os_tenant.all : t.id]`, not reported. They are the `id` of the resources: the name of tenants and LAGs, the VLAN ID of VLANs.",
+			},
+			"orphans": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of the objects of the system matching `name_prefix` that are not managed, tenants first, then VLANs, then LAGs.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the resource managing the object, for example `f5os_tenant`.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the object on the system.",
+						},
+						"import_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "ID to import the object with, its name or, for VLANs, its VLAN ID.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Status the system reports for tenants, for example `Pending` for a tenant whose deployment did not complete. Null for the other objects.",
+						},
+					},
+				},
+			},
+			"import_blocks": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Terraform `import` blocks of the objects of `orphans`.",
+			},
+		},
+	}
+}
+
+func (d *OrphansDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *OrphansDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrphansDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client.PlatformType == "Velos Controller" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_orphans` data source is supported with Velos Partition level/rSeries appliance.")
+		return
+	}
+	resourceTypes := make(map[string]bool)
+	for _, resourceType := range data.ResourceTypes {
+		resourceTypes[resourceType.ValueString()] = true
+	}
+	wanted := func(resourceType string) bool {
+		return len(resourceTypes) == 0 || resourceTypes[resourceType]
+	}
+	managed := make(map[string]bool)
+	for _, id := range data.ManagedIDs {
+		managed[id.ValueString()] = true
+	}
+	prefix := data.NamePrefix.ValueString()
+	orphan := func(name, id string) bool {
+		return strings.HasPrefix(name, prefix) && !managed[id]
+	}
+
+	data.Orphans = []OrphanModel{}
+	if wanted("f5os_tenant") {
+		tenants, err := d.client.GetTenants()
+		if err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Unable to List Tenants, got error: %s", err))
+			return
+		}
+		for _, tenant := range tenants.Tenants.Tenant {
+			if orphan(tenant.Name, tenant.Name) {
+				data.Orphans = append(data.Orphans, OrphanModel{
+					ResourceType: types.StringValue("f5os_tenant"),
+					Name:         types.StringValue(tenant.Name),
+					ImportID:     types.StringValue(tenant.Name),
+					Status:       types.StringValue(tenant.State.Status),
+				})
+			}
+		}
+	}
+	if wanted("f5os_vlan") {
+		vlans, err := d.client.GetVlans()
+		if err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Unable to List VLANs, got error: %s", err))
+			return
+		}
+		sort.Slice(vlans, func(i, j int) bool { return vlans[i].VlanID < vlans[j].VlanID })
+		for _, vlan := range vlans {
+			if id := fmt.Sprintf("%d", vlan.VlanID); orphan(vlan.Config.Name, id) {
+				data.Orphans = append(data.Orphans, orphanModel("f5os_vlan", vlan.Config.Name, id))
+			}
+		}
+	}
+	if wanted("f5os_lag") {
+		intfs, err := d.client.GetInterfaces()
+		if err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Unable to List Interfaces, got error: %s", err))
+			return
+		}
+		for _, intf := range intfs {
+			intfType := intf.State.Type
+			if intfType == "" {
+				intfType = intf.Config.Type
+			}
+			if strings.TrimPrefix(intfType, "iana-if-type:") == "ieee8023adLag" && orphan(intf.Name, intf.Name) {
+				data.Orphans = append(data.Orphans, orphanModel("f5os_lag", intf.Name, intf.Name))
+			}
+		}
+	}
+	tflog.Info(ctx, fmt.Sprintf("Found %d objects with prefix %s not managed", len(data.Orphans), prefix))
+
+	imports := make([]ImportModel, 0, len(data.Orphans))
+	for _, orphan := range data.Orphans {
+		imports = append(imports, importModel(orphan.ResourceType.ValueString(), orphan.Name.ValueString(), orphan.ImportID.ValueString()))
+	}
+	data.ImportBlocks = types.StringValue(importBlocks(imports))
+	data.ID = types.StringValue(fmt.Sprintf("%s-orphans-%s", d.client.Host, prefix))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func orphanModel(resourceType, name, id string) OrphanModel {
+	return OrphanModel{
+		ResourceType: types.StringValue(resourceType),
+		Name:         types.StringValue(name),
+		ImportID:     types.StringValue(id),
+		Status:       types.StringNull(),
+	}
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccOrphansDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrphansDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_orphans.test", "orphans.#"),
+					resource.TestCheckResourceAttrSet("data.f5os_orphans.test", "import_blocks"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrphansDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	// tf-web was left pending by an interrupted apply, tf-db is in the state
+	mux.HandleFunc("/restconf/data/f5-tenants:tenants", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", `{"f5-tenants:tenants": {"tenant": [
+			{"name": "tf-web", "state": {"status": "Pending"}},
+			{"name": "tf-db", "state": {"status": "Running"}},
+			{"name": "bigip-manual", "state": {"status": "Running"}}]}}`)
+	})
+	mux.HandleFunc("/restconf/data/openconfig-vlan:vlans", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", `{"openconfig-vlan:vlans": {"vlan": [
+			{"vlan-id": 20, "config": {"vlan-id": 20, "name": "tf-internal"}},
+			{"vlan-id": 10, "config": {"vlan-id": 10, "name": "external"}}]}}`)
+	})
+	mux.HandleFunc("/restconf/data/openconfig-interfaces:interfaces", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", `{"openconfig-interfaces:interfaces": {"interface": [
+			{"name": "1.0", "config": {"name": "1.0", "type": "iana-if-type:ethernetCsmacd"}},
+			{"name": "tf-lag", "config": {"name": "tf-lag", "type": "iana-if-type:ieee8023adLag"}}]}}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrphansDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_orphans.test", "orphans.#", "3"),
+					resource.TestCheckResourceAttr("data.f5os_orphans.test", "orphans.0.resource_type", "f5os_tenant"),
+					resource.TestCheckResourceAttr("data.f5os_orphans.test", "orphans.0.name", "tf-web"),
+					resource.TestCheckResourceAttr("data.f5os_orphans.test", "orphans.0.status", "Pending"),
+					resource.TestCheckResourceAttr("data.f5os_orphans.test", "orphans.1.resource_type", "f5os_vlan"),
+					resource.TestCheckResourceAttr("data.f5os_orphans.test", "orphans.1.name", "tf-internal"),
+					resource.TestCheckResourceAttr("data.f5os_orphans.test", "orphans.1.import_id", "20"),
+					resource.TestCheckNoResourceAttr("data.f5os_orphans.test", "orphans.1.status"),
+					resource.TestCheckResourceAttr("data.f5os_orphans.test", "orphans.2.import_id", "tf-lag"),
+					resource.TestCheckResourceAttr("data.f5os_orphans.test", "import_blocks", testAccOrphansDatasourceBlocks),
+				),
+			},
+			{
+				Config: testAccOrphansDatasourceTenantConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_orphans.test", "orphans.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOrphansDatasourceConfig = `
+data "f5os_orphans" "test" {
+  name_prefix = "tf-"
+  managed_ids = ["tf-db"]
+}
+`
+
+const testAccOrphansDatasourceTenantConfig = `
+data "f5os_orphans" "test" {
+  name_prefix    = "tf-"
+  resource_types = ["f5os_tenant"]
+  managed_ids    = ["tf-db", "tf-web"]
+}
+`
+
+const testAccOrphansDatasourceBlocks = `import {
+  to = f5os_tenant.tf-web
+  id = "tf-web"
+}
+
+import {
+  to = f5os_vlan.tf-internal
+  id = "20"
+}
+
+import {
+  to = f5os_lag.tf-lag
+  id = "tf-lag"
+}
+`
@@ -15,6 +15,10 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PartitionChangePasswordResource{}
+var _ resource.ResourceWithImportState = &PartitionChangePasswordResource{}
+
+// changePasswordImportUnsupported tells why f5os_partition_change_password cannot be imported.
+const changePasswordImportUnsupported = "the password of the user is not readable from the partition"
 
 func NewPartitionChangePasswordResource() resource.Resource {
 	return &PartitionChangePasswordResource{}
@@ -39,7 +43,8 @@ func (r *PartitionChangePasswordResource) Metadata(ctx context.Context, req reso
 func (r *PartitionChangePasswordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Resource used to manage password of a specific user on a velos chassis partition.",
+		MarkdownDescription: "Resource used to manage password of a specific user on a velos chassis partition.\n\n" +
+			"The resource cannot be imported, " + changePasswordImportUnsupported + ".",
 
 		Attributes: map[string]schema.Attribute{
 			"user_name": schema.StringAttribute{
@@ -160,6 +165,10 @@ func (r *PartitionChangePasswordResource) Delete(ctx context.Context, req resour
 	}
 }
 
+func (r *PartitionChangePasswordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importNotSupported(resp, "f5os_partition_change_password", changePasswordImportUnsupported)
+}
+
 func getPartitionPasswordChangeConfig(data *PartitionChangePasswordResourceModel) *f5ossdk.F5ReqPartitionPassChange {
 	passwordObj := f5ossdk.F5ReqPartitionPassChange{}
 	passwordObj.OldPassword = data.OldPassword.ValueString()
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -263,6 +264,17 @@ func (r *PartitionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	exists, err := r.client.PartitionExists(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Partition, got error: %s", err))
+		return
+	}
+	if !exists {
+		tflog.Warn(ctx, fmt.Sprintf("Partition %s no longer on the controller, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	partData, err := r.client.GetPartition(data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Partition, got error: %s", err))
@@ -288,6 +300,8 @@ func (r *PartitionResource) Read(ctx context.Context, req resource.ReadRequest,
 		}
 
 		data.Slots = slots
+	} else if !data.Slots.IsNull() {
+		data.Slots = types.ListValueMust(types.Int64Type, []attr.Value{})
 	}
 
 	// Save data into Terraform state
@@ -320,20 +334,19 @@ func (r *PartitionResource) Update(ctx context.Context, req resource.UpdateReque
 			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read Partition slots got error: %s", err))
 			return
 		}
-		if slotData != nil {
-			// first we determine if a subset of slots on partition are not included in user data, and if yes we remove them first
-			var slots []int64
-			data.Slots.ElementsAs(ctx, &slots, false)
-			slotDiff := getIntSliceDifference(slotData, slots)
-			if len(slotDiff) > 0 {
-				_, err := r.client.SetSlot("none", slotDiff)
-				if err != nil {
-					resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to disassociate slots from Partition, got error: %s", err))
-					return
-				}
+		// first we determine if a subset of slots on partition are not included in user data, and if yes we remove them first
+		var slots []int64
+		data.Slots.ElementsAs(ctx, &slots, false)
+		slotDiff := getIntSliceDifference(slotData, slots)
+		if len(slotDiff) > 0 {
+			_, err := r.client.SetSlot("none", slotDiff)
+			if err != nil {
+				resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to disassociate slots from Partition, got error: %s", err))
+				return
 			}
-			// next we update slots on partition
-			data.Slots.ElementsAs(ctx, &slots, false)
+		}
+		// next we assign the slots the partition does not have yet, also when it has none
+		if len(getIntSliceDifference(slots, slotData)) > 0 {
 			_, err := r.client.SetSlot(data.Name.ValueString(), slots)
 			if err != nil {
 				resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to update slots on Partition, got error: %s", err))
@@ -346,7 +359,7 @@ func (r *PartitionResource) Update(ctx context.Context, req resource.UpdateReque
 
 	respByte, err := r.client.UpdatePartition(data.Name.ValueString(), partitionConfig)
 	if err != nil {
-		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Tenant Deploy failed, got error: %s", err))
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Update Partition failed, got error: %s", err))
 		return
 	}
 	tflog.Info(ctx, fmt.Sprintf("partitionConfig Data:%+v", string(respByte)))
@@ -418,13 +431,15 @@ func (r *PartitionResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	err3 := r.client.DeletePartition(data.Name.ValueString())
 	if err3 != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to Partition, got error: %s", err3))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Partition, got error: %s", err3))
 		return
 	}
 }
 
 func (r *PartitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), 360)...)
 }
 
 func getPartitionUpdateConfig(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) *f5ossdk.F5ReqPartition {
@@ -476,11 +491,18 @@ func (r *PartitionResource) partitionResourceModelToState(ctx context.Context, r
 	if respData.Partition[0].Config.MgmtIp.Ipv4.PrefixLength != 0 {
 		data.IPv4MgmtAddress = types.StringValue(fmt.Sprintf("%s/%d", respData.Partition[0].Config.MgmtIp.Ipv4.Address, int64(respData.Partition[0].Config.MgmtIp.Ipv4.PrefixLength)))
 		data.IPv4MgmtGateway = types.StringValue(respData.Partition[0].Config.MgmtIp.Ipv4.Gateway)
+	} else if !data.IPv4MgmtAddress.IsNull() {
+		// the management address was removed on the controller
+		data.IPv4MgmtAddress = types.StringNull()
+		data.IPv4MgmtGateway = types.StringNull()
 	}
 
 	if respData.Partition[0].Config.MgmtIp.Ipv6.PrefixLength != 0 {
 		data.IPv6MgmtAddress = types.StringValue(fmt.Sprintf("%s/%d", respData.Partition[0].Config.MgmtIp.Ipv6.Address, int64(respData.Partition[0].Config.MgmtIp.Ipv6.PrefixLength)))
 		data.IPv6MgmtGateway = types.StringValue(respData.Partition[0].Config.MgmtIp.Ipv6.Gateway)
+	} else if !data.IPv6MgmtAddress.IsNull() {
+		data.IPv6MgmtAddress = types.StringNull()
+		data.IPv6MgmtGateway = types.StringNull()
 	}
 }
 
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PhoneHomeResource{}
+var _ resource.ResourceWithImportState = &PhoneHomeResource{}
+
+func NewPhoneHomeResource() resource.Resource {
+	return &PhoneHomeResource{}
+}
+
+// PhoneHomeResource defines the resource implementation.
+type PhoneHomeResource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+type PhoneHomeResourceModel struct {
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Endpoint types.String `tfsdk:"endpoint"`
+	Id       types.String `tfsdk:"id"`
+}
+
+func (r *PhoneHomeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_phone_home"
+}
+
+func (r *PhoneHomeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource to manage the phone-home / usage telemetry settings of F5OS based systems.\n\n~> **NOTE** Destroying this resource removes the configuration and reverts the device to its default phone-home behavior.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enables or disables sending phone-home / usage telemetry from the F5OS system.",
+				Required:            true,
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "URL of the endpoint the phone-home data is sent to.\nWhen not set, the F5OS platform default endpoint is used.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https?://\S+$`), "must be a valid http(s) URL"),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for Phone Home resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PhoneHomeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+	teemData.ProviderName = "f5os"
+	teemData.ResourceName = "f5os_phone_home"
+	r.teemData = teemData
+}
+
+func (r *PhoneHomeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *PhoneHomeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] Phone Home enabled:%+v", data.Enabled.ValueBool()))
+
+	teemInfo := make(map[string]interface{})
+	teemInfo["teemData"] = r.teemData
+	err := r.client.SendTeem(teemInfo)
+	if err != nil {
+		resp.Diagnostics.AddError("Teem Error", fmt.Sprintf("Sending Teem Data failed: %s", err))
+	}
+	_, err = r.client.PhoneHomeConfig(getPhoneHomeConfig(data))
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Create Phone Home config failed, got error: %s", err))
+		return
+	}
+	data.Id = types.StringValue("phone-home")
+
+	phoneHome, err := r.client.GetPhoneHome()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Phone Home config, got error: %s", err))
+		return
+	}
+	r.phoneHomeResourceModelToState(ctx, phoneHome, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PhoneHomeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *PhoneHomeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[READ] Phone Home:%+v", data.Id.ValueString()))
+	phoneHome, err := r.client.GetPhoneHome()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Phone Home config, got error: %s", err))
+		return
+	}
+	if phoneHome.Config == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.phoneHomeResourceModelToState(ctx, phoneHome, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PhoneHomeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *PhoneHomeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[UPDATE] Phone Home enabled:%+v", data.Enabled.ValueBool()))
+
+	_, err := r.client.PhoneHomeConfig(getPhoneHomeConfig(data))
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Update Phone Home config failed, got error: %s", err))
+		return
+	}
+	phoneHome, err := r.client.GetPhoneHome()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get Phone Home config, got error: %s", err))
+		return
+	}
+	r.phoneHomeResourceModelToState(ctx, phoneHome, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PhoneHomeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *PhoneHomeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.client.DeletePhoneHome()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to Delete Phone Home config, got error: %s", err))
+		return
+	}
+}
+
+func (r *PhoneHomeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *PhoneHomeResource) phoneHomeResourceModelToState(ctx context.Context, respData *f5ossdk.F5RespPhoneHome, data *PhoneHomeResourceModel) {
+	tflog.Debug(ctx, fmt.Sprintf("PhoneHomeResp :%+v", respData))
+	if respData.Config == nil {
+		return
+	}
+	data.Enabled = types.BoolValue(respData.Config.Enabled)
+	data.Endpoint = types.StringValue(respData.Config.Endpoint)
+}
+
+func getPhoneHomeConfig(data *PhoneHomeResourceModel) *f5ossdk.F5ReqPhoneHome {
+	phoneHomeReq := f5ossdk.F5ReqPhoneHome{}
+	phoneHomeReq.PhoneHome.Config.Enabled = data.Enabled.ValueBool()
+	if !data.Endpoint.IsUnknown() {
+		phoneHomeReq.PhoneHome.Config.Endpoint = data.Endpoint.ValueString()
+	}
+	return &phoneHomeReq
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &PlatformComponentsDataSource{}
+)
+
+func NewPlatformComponentsDataSource() datasource.DataSource {
+	return &PlatformComponentsDataSource{}
+}
+
+// PlatformComponentsDataSource defines the data source implementation.
+type PlatformComponentsDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// PlatformComponentsDataSourceModel describes the data source data model.
+type PlatformComponentsDataSourceModel struct {
+	ID         types.String             `tfsdk:"id"`
+	Components []PlatformComponentModel `tfsdk:"components"`
+}
+
+type PlatformComponentModel struct {
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	SerialNo         types.String `tfsdk:"serial_no"`
+	PartNo           types.String `tfsdk:"part_no"`
+	Empty            types.Bool   `tfsdk:"empty"`
+	OperStatus       types.String `tfsdk:"oper_status"`
+	PowerState       types.String `tfsdk:"power_state"`
+	FirmwareVersions types.Map    `tfsdk:"firmware_versions"`
+	SoftwareVersions types.Map    `tfsdk:"software_versions"`
+}
+
+func (d *PlatformComponentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_components"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *PlatformComponentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the hardware inventory of F5OS based systems: chassis, blades, cards, power supplies, fans and their serial numbers and firmware versions.\n\n" +
+			"Use this data source to feed the hardware of VELOS controllers or rSeries appliances into an inventory.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"components": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of platform components, in the order reported by the system.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the component, for example `platform`, `psu-1` or `blade-1`.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Description of the component, for example the platform model.",
+						},
+						"serial_no": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Serial number of the component.",
+						},
+						"part_no": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Part number of the component.",
+						},
+						"empty": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the slot of the component is empty.",
+						},
+						"oper_status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Operational status of the component, for example `ACTIVE`, empty when not reported.",
+						},
+						"power_state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Power state of the component, empty when not reported.",
+						},
+						"firmware_versions": schema.MapAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Firmware versions of the component, keyed by firmware, for example `bios` or `cpld`.",
+						},
+						"software_versions": schema.MapAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Software versions running on the component, keyed by software, for example `blade-os`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PlatformComponentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *PlatformComponentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PlatformComponentsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Components = []PlatformComponentModel{}
+	// the components of a fully populated chassis are read in pages
+	err := d.client.EachPlatformComponent(nil, func(component f5ossdk.F5RespPlatformComponent) error {
+		tflog.Debug(ctx, fmt.Sprintf("Platform Component :%+v", component.Name))
+		firmwareVersions := make(map[string]string)
+		for _, property := range component.Properties.Property {
+			if firmware, ok := strings.CutPrefix(property.Name, "fw-version-"); ok {
+				firmwareVersions[firmware] = fmt.Sprint(property.State.Value)
+			}
+		}
+		softwareVersions := make(map[string]string)
+		for _, software := range component.Software.State.SoftwareComponents.SoftwareComponent {
+			softwareVersions[software.SoftwareIndex] = software.State.Version
+		}
+		firmwareMap, diags := types.MapValueFrom(ctx, types.StringType, firmwareVersions)
+		resp.Diagnostics.Append(diags...)
+		softwareMap, diags := types.MapValueFrom(ctx, types.StringType, softwareVersions)
+		resp.Diagnostics.Append(diags...)
+		data.Components = append(data.Components, PlatformComponentModel{
+			Name:             types.StringValue(component.Name),
+			Description:      types.StringValue(component.State.Description),
+			SerialNo:         types.StringValue(component.State.SerialNo),
+			PartNo:           types.StringValue(component.State.PartNo),
+			Empty:            types.BoolValue(component.State.EmptyState),
+			OperStatus:       types.StringValue(strings.TrimPrefix(component.State.OperStatus, "openconfig-platform-types:")),
+			PowerState:       types.StringValue(component.State.PowerState),
+			FirmwareVersions: firmwareMap,
+			SoftwareVersions: softwareMap,
+		})
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Get Platform Components", fmt.Sprintf("Error:%s", err))
+		return
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s-platform-components", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
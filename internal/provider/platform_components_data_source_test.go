@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccPlatformComponentsDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlatformComponentsDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_platform_components.test", "components.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPlatformComponentsDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/rseries_platform_state_ok.json"))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlatformComponentsDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_platform_components.test", "components.#", "3"),
+					resource.TestCheckResourceAttr("data.f5os_platform_components.test", "components.1.name", "platform"),
+					resource.TestCheckResourceAttr("data.f5os_platform_components.test", "components.1.description", "r5900"),
+					resource.TestCheckResourceAttr("data.f5os_platform_components.test", "components.1.serial_no", "f5-knvr-ejci"),
+					resource.TestCheckResourceAttr("data.f5os_platform_components.test", "components.1.firmware_versions.bios", "2.01.134.1"),
+					resource.TestCheckNoResourceAttr("data.f5os_platform_components.test", "components.1.firmware_versions.QAT0"),
+					resource.TestCheckResourceAttr("data.f5os_platform_components.test", "components.2.name", "psu-1"),
+					resource.TestCheckResourceAttr("data.f5os_platform_components.test", "components.2.part_no", "M1845"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPlatformComponentsDatasourceConfig = `
+data "f5os_platform_components" "test" {}
+`
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// allPlatforms are the platform profiles of F5OS, in the order they are documented.
+var allPlatforms = []string{f5ossdk.PlatformVelosController, f5ossdk.PlatformVelosPartition, f5ossdk.PlatformRSeries}
+
+var tenantPlatforms = []string{f5ossdk.PlatformVelosPartition, f5ossdk.PlatformRSeries}
+var systemPlatforms = []string{f5ossdk.PlatformVelosController, f5ossdk.PlatformRSeries}
+var controllerPlatforms = []string{f5ossdk.PlatformVelosController}
+
+// resourcePlatforms declares the platform profiles every resource and data source type supports,
+// the others not exposing the API it manages.
+var resourcePlatforms = map[string][]string{
+	"f5os_allowed_ips":               allPlatforms,
+	"f5os_api_stats":                 allPlatforms,
+	"f5os_auth_order":                allPlatforms,
+	"f5os_auth_server_group":         allPlatforms,
+	"f5os_available_upgrades":        systemPlatforms,
+	"f5os_blade_software_versions":   controllerPlatforms,
+	"f5os_blades":                    controllerPlatforms,
+	"f5os_bootstrap":                 allPlatforms,
+	"f5os_config_backup":             allPlatforms,
+	"f5os_config_restore":            allPlatforms,
+	"f5os_controller_config_sync":    controllerPlatforms,
+	"f5os_dns":                       allPlatforms,
+	"f5os_dns_host":                  allPlatforms,
+	"f5os_imports":                   tenantPlatforms,
+	"f5os_interface":                 tenantPlatforms,
+	"f5os_interface_descriptions":    tenantPlatforms,
+	"f5os_interface_error_rates":     tenantPlatforms,
+	"f5os_interface_ifindex":         tenantPlatforms,
+	"f5os_interfaces":                tenantPlatforms,
+	"f5os_lag":                       tenantPlatforms,
+	"f5os_logging":                   allPlatforms,
+	"f5os_ntp":                       allPlatforms,
+	"f5os_orphans":                   tenantPlatforms,
+	"f5os_partition":                 controllerPlatforms,
+	"f5os_partition_change_password": {f5ossdk.PlatformVelosPartition},
+	"f5os_phone_home":                allPlatforms,
+	"f5os_platform_components":       allPlatforms,
+	"f5os_primary_key":               allPlatforms,
+	"f5os_qkview":                    allPlatforms,
+	"f5os_qkviews":                   allPlatforms,
+	"f5os_restconf":                  allPlatforms,
+	"f5os_sessions":                  allPlatforms,
+	"f5os_sessions_clear":            allPlatforms,
+	"f5os_snmp_community":            allPlatforms,
+	"f5os_snmp_mib":                  allPlatforms,
+	"f5os_snmp_target":               allPlatforms,
+	"f5os_snmp_user":                 allPlatforms,
+	"f5os_system_image":              systemPlatforms,
+	"f5os_system_proxy":              allPlatforms,
+	"f5os_system_settings":           allPlatforms,
+	"f5os_system_upgrade":            systemPlatforms,
+	"f5os_tenant":                    tenantPlatforms,
+	"f5os_tenant_image":              tenantPlatforms,
+	"f5os_tenant_image_cleanup":      tenantPlatforms,
+	"f5os_tenants":                   tenantPlatforms,
+	"f5os_time_drift":                allPlatforms,
+	"f5os_tls_cert_key":              allPlatforms,
+	"f5os_vlan":                      tenantPlatforms,
+	"f5os_vlans":                     tenantPlatforms,
+}
+
+// platformNames are the names of the platform profiles in diagnostics.
+var platformNames = map[string]string{
+	f5ossdk.PlatformVelosController: "VELOS controllers",
+	f5ossdk.PlatformVelosPartition:  "VELOS partitions",
+	f5ossdk.PlatformRSeries:         "rSeries appliances",
+}
+
+// platformState is the platform of the device of the provider.
+type platformState struct {
+	profile atomic.Value
+	// platformType is the type reported by the device, such as r5900 for rSeries appliances.
+	platformType atomic.Value
+}
+
+func (s *platformState) store(client *f5ossdk.F5os) {
+	s.profile.Store(client.PlatformProfile())
+	s.platformType.Store(client.PlatformType)
+}
+
+// checkPlatform reports an error when the device of the provider is of a platform the type
+// typeName does not support, before the requests to its API fail with a 404. Nothing is reported
+// until the provider is configured.
+func (p *F5osProvider) checkPlatform(typeName string) []*tfprotov6.Diagnostic {
+	profile, _ := p.platform.profile.Load().(string)
+	platforms, declared := resourcePlatforms[typeName]
+	if profile == "" || !declared || supportsPlatform(platforms, profile) {
+		return nil
+	}
+	platformType, _ := p.platform.platformType.Load().(string)
+	supported := make([]string, 0, len(platforms))
+	for _, platform := range platforms {
+		supported = append(supported, platformNames[platform])
+	}
+	return []*tfprotov6.Diagnostic{{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "Unsupported F5OS Platform",
+		Detail: fmt.Sprintf("%s is supported on %s, not on %s: the provider is connected to a %s system.",
+			typeName, strings.Join(supported, " and "), platformNames[profile], platformType),
+	}}
+}
+
+func supportsPlatform(platforms []string, profile string) bool {
+	for _, platform := range platforms {
+		if platform == profile {
+			return true
+		}
+	}
+	return false
+}
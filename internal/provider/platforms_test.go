@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	helper "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// TestUnitPlatformProfiles simulates every platform profile and checks, for every type, that the
+// profiles it declares are accepted and the others refused by checkPlatform.
+func TestUnitPlatformProfiles(t *testing.T) {
+	typeNames := make([]string, 0, len(resourcePlatforms))
+	for typeName := range resourcePlatforms {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+	for _, profile := range allPlatforms {
+		t.Run(profile, func(t *testing.T) {
+			testAccPreUnitPlatformCheck(t, profile)
+			defer teardown()
+			client, err := f5ossdk.NewSession(&f5ossdk.F5osConfig{Host: server.URL, User: "testuser", Password: "testpass", Retries: -1})
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, profile, client.PlatformProfile(), "Expected the simulated platform to be detected")
+			p := &F5osProvider{}
+			p.platform.store(client)
+			for _, typeName := range typeNames {
+				diags := p.checkPlatform(typeName)
+				if supportsPlatform(resourcePlatforms[typeName], profile) {
+					assert.Empty(t, diags, "Expected %s to be supported on %s", typeName, profile)
+					continue
+				}
+				if assert.Len(t, diags, 1, "Expected %s to be refused on %s", typeName, profile) {
+					assert.Equal(t, "Unsupported F5OS Platform", diags[0].Summary)
+					assert.Contains(t, diags[0].Detail, fmt.Sprintf("%s is supported on ", typeName))
+					assert.Contains(t, diags[0].Detail, fmt.Sprintf("not on %s: the provider is connected to a %s system.", platformNames[profile], client.PlatformType))
+				}
+			}
+		})
+	}
+	p := &F5osProvider{}
+	assert.Empty(t, p.checkPlatform("f5os_vlan"), "Expected nothing to be refused before the provider is configured")
+}
+
+func TestUnitResourcePlatforms(t *testing.T) {
+	ctx := context.Background()
+	p := &F5osProvider{version: "test"}
+	var typeNames []string
+	for _, newResource := range p.Resources(ctx) {
+		resp := &resource.MetadataResponse{}
+		newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "f5os"}, resp)
+		typeNames = append(typeNames, resp.TypeName)
+	}
+	for _, newDataSource := range p.DataSources(ctx) {
+		resp := &datasource.MetadataResponse{}
+		newDataSource().Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "f5os"}, resp)
+		typeNames = append(typeNames, resp.TypeName)
+	}
+	for _, typeName := range typeNames {
+		platforms, declared := resourcePlatforms[typeName]
+		if assert.True(t, declared, "Expected the platforms of %s to be declared", typeName) {
+			assert.NotEmpty(t, platforms, "Expected %s to support a platform", typeName)
+			assert.Subset(t, allPlatforms, platforms, "Expected %s to support platform profiles", typeName)
+		}
+	}
+}
+
+// TestAccPlatformsUnitTC1 plans a VLAN against every simulated platform, the VELOS controllers
+// not exposing the VLANs of the tenants.
+func TestAccPlatformsUnitTC1(t *testing.T) {
+	for profile := range testAccPlatformProfiles {
+		t.Run(profile, func(t *testing.T) {
+			testAccPreUnitPlatformCheck(t, profile)
+			mux.HandleFunc("/restconf/data/openconfig-vlan:vlans/vlan=1", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			})
+			defer teardown()
+			step := helper.TestStep{
+				Config:             testAccPlatformsVlanConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			}
+			if !supportsPlatform(resourcePlatforms["f5os_vlan"], profile) {
+				step.ExpectError = regexp.MustCompile("f5os_vlan is supported on VELOS partitions and rSeries appliances")
+			}
+			helper.Test(t, helper.TestCase{
+				IsUnitTest:               true,
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps:                    []helper.TestStep{step},
+			})
+		})
+	}
+}
+
+const testAccPlatformsVlanConfig = `
+resource "f5os_vlan" "test" {
+  vlan_id = 1
+  name    = "vlan-test"
+}
+`
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &PrimaryKeyDataSource{}
+)
+
+func NewPrimaryKeyDataSource() datasource.DataSource {
+	return &PrimaryKeyDataSource{}
+}
+
+// PrimaryKeyDataSource defines the data source implementation.
+type PrimaryKeyDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// PrimaryKeyDataSourceModel describes the data source data model.
+type PrimaryKeyDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	ExpectedHash      types.String `tfsdk:"expected_hash"`
+	IsSet             types.Bool   `tfsdk:"is_set"`
+	Hash              types.String `tfsdk:"hash"`
+	Status            types.String `tfsdk:"status"`
+	BackupsCompatible types.Bool   `tfsdk:"backups_compatible"`
+}
+
+func (d *PrimaryKeyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_primary_key"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *PrimaryKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the status of the primary key encrypting the secrets of the F5OS system configuration and its backups.\n\n" +
+			"Use this data source before restoring a configuration backup to verify the target system uses the primary key the backup was taken with, otherwise the secrets of the backup can not be decrypted.",
+
+		Attributes: map[string]schema.Attribute{
+			"expected_hash": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Hash of the primary key of the system the backup was taken on, as reported by `hash`.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"is_set": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Set to `true` when a primary key has been set on the system.",
+			},
+			"hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Hash of the primary key, derived from its passphrase and salt.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of the last primary key change, as reported by the system.",
+			},
+			"backups_compatible": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Set to `true` when `hash` matches `expected_hash`, so backups taken with that primary key decrypt on the system.\nNot set when `expected_hash` is not configured.",
+			},
+		},
+	}
+}
+
+func (d *PrimaryKeyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *PrimaryKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PrimaryKeyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	primaryKey, err := d.client.GetPrimaryKey()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Get Primary Key Status", fmt.Sprintf("Error:%s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Primary Key :%+v", primaryKey))
+	data.Hash = types.StringValue(primaryKey.State.Hash)
+	data.Status = types.StringValue(primaryKey.State.Status)
+	data.IsSet = types.BoolValue(primaryKey.State.Hash != "")
+	data.BackupsCompatible = types.BoolNull()
+	if !data.ExpectedHash.IsNull() {
+		data.BackupsCompatible = types.BoolValue(primaryKey.State.Hash != "" && strings.TrimSpace(data.ExpectedHash.ValueString()) == primaryKey.State.Hash)
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s-primary-key", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccPrimaryKeyDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrimaryKeyDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_primary_key.test", "is_set"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPrimaryKeyDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa/f5-primary-key:primary-key/state", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"f5-primary-key:state": {"hash": "mQbDcW5hgOjNbAJp6Ya7/bUrYKB+Xfg0S4ixBJU5vTw=", "status": "COMPLETE   Initiated: Mon Jun 12 09:14:03 2023"}}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrimaryKeyDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_primary_key.test", "is_set", "true"),
+					resource.TestCheckResourceAttr("data.f5os_primary_key.test", "hash", "mQbDcW5hgOjNbAJp6Ya7/bUrYKB+Xfg0S4ixBJU5vTw="),
+					resource.TestCheckNoResourceAttr("data.f5os_primary_key.test", "backups_compatible"),
+				),
+			},
+			{
+				Config: testAccPrimaryKeyExpectedDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_primary_key.test", "backups_compatible", "false"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPrimaryKeyDatasourceConfig = `
+data "f5os_primary_key" "test" {}
+`
+
+const testAccPrimaryKeyExpectedDatasourceConfig = `
+data "f5os_primary_key" "test" {
+  expected_hash = "Zm9vYmFyYmF6cXV4cXV1eGNvcmdlZ3JhdWx0Z2FycGx5Cg=="
+}
+`
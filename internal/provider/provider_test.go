@@ -1,13 +1,24 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strconv"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
 )
 
 const (
@@ -34,7 +45,9 @@ var (
 	// CLI command executed to create a provider server to which the CLI can
 	// reattach.
 	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-		"f5os": providerserver.NewProtocol6WithError(New("devel")()),
+		"f5os": func() (tfprotov6.ProviderServer, error) {
+			return NewProtocol6("devel")(), nil
+		},
 	}
 )
 
@@ -61,6 +74,60 @@ func testAccPreUnitCheck(t *testing.T) {
 	//defer teardown()
 }
 
+// testAccPlatformProfiles are the fixtures of the platform components answered by the simulated
+// devices of every platform profile.
+var testAccPlatformProfiles = map[string]string{
+	f5ossdk.PlatformVelosController: "./fixtures/velos_controller_components.json",
+	f5ossdk.PlatformVelosPartition:  "./fixtures/velos_partition_components.json",
+	f5ossdk.PlatformRSeries:         "./fixtures/rseries_platform_state_ok.json",
+}
+
+// testAccPreUnitPlatformCheck starts the test server simulating a device of the platform profile,
+// which authenticates the client and answers the platform components and version, the tests
+// adding the handlers of the API they use.
+func testAccPreUnitPlatformCheck(t *testing.T, profile string) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString(testAccPlatformProfiles[profile]))
+	})
+	switch profile {
+	case f5ossdk.PlatformVelosController:
+		mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-controller-image:image", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/velos_controller_image.json"))
+		})
+	case f5ossdk.PlatformRSeries:
+		mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-image:image/state/install", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/rseries_platform_version.json"))
+		})
+	}
+}
+
+// testAccPagedListHandler answers the list member with the entries of the page the offset and
+// limit query parameters select, as the devices do for the list iterators of the client.
+func testAccPagedListHandler(member string, entries []json.RawMessage) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		end := len(entries)
+		if limit, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && offset+limit < end {
+			end = offset + limit
+		}
+		page := []json.RawMessage{}
+		if offset < end {
+			page = entries[offset:end]
+		}
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string][]json.RawMessage{member: page})
+	}
+}
+
 func setup() {
 	// test server
 	mux = http.NewServeMux()
@@ -84,3 +151,179 @@ func loadFixtureBytes(path string) []byte {
 func loadFixtureString(path string) string {
 	return string(loadFixtureBytes(path))
 }
+
+// TestUnitSessionTokenRenewal expires the session token on the test server once the session is
+// created, the requests rejected with 401 being replayed with the token of a new login whatever
+// the number of retries.
+func TestUnitSessionTokenRenewal(t *testing.T) {
+	for _, retries := range []int{-1, 2} {
+		t.Run(fmt.Sprintf("retries %d", retries), func(t *testing.T) {
+			testAccPreUnitCheck(t)
+			defer teardown()
+			logins := 0
+			mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+				logins++
+				w.Header().Set("X-Auth-Token", fmt.Sprintf("token%d", logins))
+			})
+			mux.HandleFunc("/restconf/data/openconfig-platform:components/component", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			})
+			var tokens []string
+			mux.HandleFunc("/restconf/data/openconfig-vlan:vlans", func(w http.ResponseWriter, r *http.Request) {
+				tokens = append(tokens, r.Method+" "+r.Header.Get("X-Auth-Token"))
+				// the token of the first login expired
+				if r.Header.Get("X-Auth-Token") == "token1" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			})
+			client, err := f5ossdk.NewSession(&f5ossdk.F5osConfig{Host: server.URL, User: "testuser", Password: "testpass", Retries: retries})
+			if !assert.NoError(t, err) {
+				return
+			}
+			_, err = client.GetRequest("/openconfig-vlan:vlans")
+			assert.NoError(t, err)
+			_, err = client.PatchRequest("/openconfig-vlan:vlans", []byte(`{}`))
+			assert.NoError(t, err)
+			assert.Equal(t, 2, logins, "Expected the expired token to be renewed once")
+			assert.Equal(t, []string{"GET token1", "GET token2", "PATCH token2"}, tokens)
+		})
+	}
+}
+
+// TestUnitReadOnlySession refuses the requests changing the device before they are sent, the
+// test server failing the test on any write, while reads and read-only RPCs still reach it.
+func TestUnitReadOnlySession(t *testing.T) {
+	testAccPreUnitCheck(t)
+	defer teardown()
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	reads := 0
+	mux.HandleFunc("/restconf/data/openconfig-vlan:vlans", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Unexpected %s of the VLANs in read-only mode", r.Method)
+		}
+		reads++
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"openconfig-vlan:vlans":{"vlan":[]}}`)
+	})
+	mux.HandleFunc("/restconf/data/f5-utils-file-transfer:file/list", func(w http.ResponseWriter, r *http.Request) {
+		reads++
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"f5-utils-file-transfer:output":{"entries":[]}}`)
+	})
+	client, err := f5ossdk.NewSession(&f5ossdk.F5osConfig{Host: server.URL, User: "testuser", Password: "testpass", Retries: -1, ReadOnly: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, client.ReadOnly())
+
+	_, err = client.GetRequest("/openconfig-vlan:vlans")
+	assert.NoError(t, err)
+	_, err = client.PostRequest("/f5-utils-file-transfer:file/list", []byte(`{"f5-utils-file-transfer:path":"configs"}`))
+	assert.NoError(t, err, "Expected the file list RPC to be allowed")
+
+	_, err = client.PostRequest("/openconfig-vlan:vlans", []byte(`{}`))
+	assert.ErrorIs(t, err, f5ossdk.ErrReadOnly)
+	_, err = client.PatchRequest("/openconfig-vlan:vlans", []byte(`{}`))
+	assert.ErrorIs(t, err, f5ossdk.ErrReadOnly)
+	_, err = client.PutRequest("/openconfig-vlan:vlans", []byte(`{}`))
+	assert.ErrorIs(t, err, f5ossdk.ErrReadOnly)
+	err = client.DeleteRequest("/openconfig-vlan:vlans")
+	assert.ErrorIs(t, err, f5ossdk.ErrReadOnly)
+	assert.Equal(t, 2, reads)
+}
+
+// TestUnitResourcesImport checks that every resource of the provider either imports its state or
+// refuses the import with a diagnostic telling why.
+func TestUnitResourcesImport(t *testing.T) {
+	notSupported := map[string]bool{
+		"f5os_bootstrap":                 true,
+		"f5os_config_backup":             true,
+		"f5os_config_restore":            true,
+		"f5os_partition_change_password": true,
+		"f5os_qkview":                    true,
+		"f5os_sessions_clear":            true,
+		"f5os_tenant_image_cleanup":      true,
+		"f5os_tls_cert_key":              true,
+	}
+	ctx := context.Background()
+	for _, newResource := range (&F5osProvider{}).Resources(ctx) {
+		res := newResource()
+		metadata := &resource.MetadataResponse{}
+		res.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "f5os"}, metadata)
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			importer, ok := res.(resource.ResourceWithImportState)
+			if !assert.True(t, ok, "Expected the resource to implement ImportState") || !notSupported[metadata.TypeName] {
+				return
+			}
+			resp := &resource.ImportStateResponse{}
+			importer.ImportState(ctx, resource.ImportStateRequest{ID: "test"}, resp)
+			if assert.True(t, resp.Diagnostics.HasError()) {
+				assert.Equal(t, "Import Not Supported", resp.Diagnostics.Errors()[0].Summary())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), metadata.TypeName+" cannot be imported, ")
+			}
+		})
+	}
+}
+
+// TestUnitConfigureConflictingTokens refuses a configuration setting both api_token and
+// token_file, from the provider block or the environment, before any session is created.
+func TestUnitConfigureConflictingTokens(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config map[string]string
+		env    map[string]string
+	}{
+		{
+			name:   "provider block",
+			config: map[string]string{"api_token": "token1", "token_file": "/run/f5os/token"},
+		},
+		{
+			name:   "api_token and F5OS_TOKEN_FILE",
+			config: map[string]string{"api_token": "token1"},
+			env:    map[string]string{"F5OS_TOKEN_FILE": "/run/f5os/token"},
+		},
+		{
+			name: "environment",
+			env:  map[string]string{"F5OS_API_TOKEN": "token1", "F5OS_TOKEN_FILE": "/run/f5os/token"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, name := range []string{"F5OS_API_TOKEN", "F5OS_TOKEN_FILE"} {
+				t.Setenv(name, tc.env[name])
+			}
+			ctx := context.Background()
+			p := &F5osProvider{}
+			schemaResp := &provider.SchemaResponse{}
+			p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+			configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := make(map[string]tftypes.Value)
+			for name, attrType := range configType.AttributeTypes {
+				values[name] = tftypes.NewValue(attrType, nil)
+			}
+			// the device is never contacted, any login would fail the test
+			values["host"] = tftypes.NewValue(tftypes.String, "https://192.0.2.1")
+			for name, value := range tc.config {
+				values[name] = tftypes.NewValue(tftypes.String, value)
+			}
+			req := provider.ConfigureRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, values)}}
+			resp := &provider.ConfigureResponse{}
+			p.Configure(ctx, req, resp)
+
+			if assert.Len(t, resp.Diagnostics.Errors(), 1) {
+				conflict := resp.Diagnostics.Errors()[0]
+				assert.Equal(t, "Conflicting API tokens in provider configuration", conflict.Summary())
+				if withPath, ok := conflict.(diag.DiagnosticWithPath); assert.True(t, ok) {
+					assert.Equal(t, path.Root("token_file"), withPath.Path())
+				}
+			}
+			assert.Nil(t, resp.ResourceData, "Expected no session to be created")
+		})
+	}
+}
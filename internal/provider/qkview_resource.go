@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QkviewResource{}
+var _ resource.ResourceWithImportState = &QkviewResource{}
+
+// qkviewImportUnsupported tells why f5os_qkview cannot be imported.
+const qkviewImportUnsupported = "the collection options like `exclude_cores` are not kept with the qkview file, the existing qkviews are listed by the `f5os_qkviews` data source"
+
+func NewQkviewResource() resource.Resource {
+	return &QkviewResource{}
+}
+
+// QkviewResource defines the resource implementation.
+type QkviewResource struct {
+	client *f5ossdk.F5os
+}
+
+// QkviewResourceModel describes the resource data model.
+type QkviewResourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	Timeout      types.Int64  `tfsdk:"timeout"`
+	MaxFileSize  types.Int64  `tfsdk:"max_file_size"`
+	MaxCoreSize  types.Int64  `tfsdk:"max_core_size"`
+	ExcludeCores types.Bool   `tfsdk:"exclude_cores"`
+	Hostname     types.String `tfsdk:"hostname"`
+	Date         types.String `tfsdk:"date"`
+	Size         types.Int64  `tfsdk:"size"`
+	Id           types.String `tfsdk:"id"`
+}
+
+func (r *QkviewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_qkview"
+}
+
+func (r *QkviewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource used to generate a qkview diagnostic file on F5OS systems.\n\n" +
+			"The qkview is deleted from the device when the resource is destroyed, so that diagnostic files do not accumulate and fill the disk of the system.\n\n" +
+			"The resource cannot be imported, " + qkviewImportUnsupported + ".",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the qkview file, for example `support-case.tar`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "The number of seconds to wait for the qkview collection to finish, default is `900`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(900),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			"max_file_size": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size in MB of the files collected in the qkview, default is `500`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(500),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(2, 1000),
+				},
+			},
+			"max_core_size": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size in MB of the core files collected in the qkview, default is `25`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(25),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(2, 1000),
+				},
+			},
+			"exclude_cores": schema.BoolAttribute{
+				MarkdownDescription: "Exclude the core files from the qkview, default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the system the qkview was collected on.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"date": schema.StringAttribute{
+				MarkdownDescription: "Date the qkview was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Size of the qkview file in bytes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *QkviewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *QkviewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *QkviewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] Collecting qkview :%+v", data.Name.ValueString()))
+	timeout := int(data.Timeout.ValueInt64())
+	captureConfig := &f5ossdk.F5ReqQkviewCapture{
+		Filename:     data.Name.ValueString(),
+		Timeout:      timeout,
+		MaxFileSize:  int(data.MaxFileSize.ValueInt64()),
+		MaxCoreSize:  int(data.MaxCoreSize.ValueInt64()),
+		ExcludeCores: data.ExcludeCores.ValueBool(),
+	}
+	qkview, err := r.client.CaptureQkview(captureConfig, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("failure while collecting qkview, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Qkview :%+v", qkview))
+
+	data.Id = data.Name
+	qkviewModelToState(qkview, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QkviewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *QkviewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("[READ] Reading qkview :%+v", data.Id.ValueString()))
+	qkview, err := r.client.GetQkview(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get qkview, got error: %s", err))
+		return
+	}
+	if qkview == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Qkview %s no longer on the device, removing it from state", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	qkviewModelToState(qkview, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QkviewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *QkviewResourceModel
+
+	// every attribute but timeout requires replacement, there is nothing to change on the device
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QkviewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *QkviewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("[DELETE] Deleting qkview :%+v", data.Id.ValueString()))
+	err := r.client.DeleteQkview(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("failure while deleting qkview, got error: %s", err))
+		return
+	}
+}
+
+func (r *QkviewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importNotSupported(resp, "f5os_qkview", qkviewImportUnsupported)
+}
+
+func qkviewModelToState(qkview *f5ossdk.F5Qkview, data *QkviewResourceModel) {
+	data.Hostname = types.StringValue(qkview.Hostname)
+	data.Date = types.StringValue(qkview.Date)
+	data.Size = types.Int64Value(qkview.Size)
+}
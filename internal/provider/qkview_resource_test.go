@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccQkviewTC1Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQkviewConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_qkview.test", "name", "support-case.tar"),
+					resource.TestCheckResourceAttrSet("f5os_qkview.test", "size"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQkviewUnitTC1Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	var captured map[string]any
+	var deleted []string
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	qkviewURI := "/restconf/data/openconfig-system:system/f5-system-diagnostics-qkview:diagnostics/f5-system-diagnostics-qkview:qkview"
+	mux.HandleFunc(qkviewURI+"/capture", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		_ = json.NewDecoder(r.Body).Decode(&captured)
+		_, _ = fmt.Fprint(w, `{"f5-system-diagnostics-qkview:output":{"result":"Qkview file support-case.tar is being collected.","resultint":0}}`)
+	})
+	mux.HandleFunc(qkviewURI+"/status", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		_, _ = fmt.Fprint(w, `{"f5-system-diagnostics-qkview:output":{"result":"{\"Busy\":false,\"Percent\":100,\"Status\":\"complete\",\"Message\":\"Completed collection.\",\"Filename\":\"support-case.tar\"}","resultint":0}}`)
+	})
+	mux.HandleFunc(qkviewURI+"/list", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/qkview_list.json"))
+	})
+	mux.HandleFunc(qkviewURI+"/delete", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		deleteReq := make(map[string]string)
+		_ = json.NewDecoder(r.Body).Decode(&deleteReq)
+		deleted = append(deleted, deleteReq["f5-system-diagnostics-qkview:filename"])
+		_, _ = fmt.Fprint(w, `{"f5-system-diagnostics-qkview:output":{"result":"Deleted Qkview file support-case.tar.","resultint":0}}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			assert.Equal(t, []string{"support-case.tar"}, deleted, "Expected qkview support-case.tar deleted, got %v", deleted)
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQkviewConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_qkview.test", "id", "support-case.tar"),
+					resource.TestCheckResourceAttr("f5os_qkview.test", "hostname", "appliance-1.chassis.local"),
+					resource.TestCheckResourceAttr("f5os_qkview.test", "size", "148611072"),
+					resource.TestCheckResourceAttr("f5os_qkview.test", "max_file_size", "500"),
+					func(s *terraform.State) error {
+						assert.Equal(t, true, captured["f5-system-diagnostics-qkview:exclude-cores"], "Expected cores excluded, got %v", captured)
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+const testAccQkviewConfig = `
+resource "f5os_qkview" "test" {
+  name          = "support-case.tar"
+  exclude_cores = true
+}
+`
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &QkviewsDataSource{}
+)
+
+func NewQkviewsDataSource() datasource.DataSource {
+	return &QkviewsDataSource{}
+}
+
+// QkviewsDataSource defines the data source implementation.
+type QkviewsDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// QkviewsDataSourceModel describes the data source data model.
+type QkviewsDataSourceModel struct {
+	ID      types.String  `tfsdk:"id"`
+	Qkviews []QkviewModel `tfsdk:"qkviews"`
+}
+
+type QkviewModel struct {
+	Name     types.String `tfsdk:"name"`
+	Hostname types.String `tfsdk:"hostname"`
+	Date     types.String `tfsdk:"date"`
+	Size     types.Int64  `tfsdk:"size"`
+}
+
+func (d *QkviewsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_qkviews"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *QkviewsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the qkview diagnostic files stored on the F5OS device.\n\n" +
+			"Use this data source to find the qkviews left on a system, for example to check their total size before collecting another one.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"qkviews": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of qkviews stored on the device.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the qkview file.",
+						},
+						"hostname": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Hostname of the system the qkview was collected on.",
+						},
+						"date": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Date the qkview was created.",
+						},
+						"size": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Size of the qkview file in bytes.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *QkviewsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *QkviewsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data QkviewsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	qkviews, err := d.client.GetQkviews()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Get Qkviews", fmt.Sprintf("Error:%s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Qkviews :%+v", qkviews))
+	data.Qkviews = []QkviewModel{}
+	for _, qkview := range qkviews {
+		data.Qkviews = append(data.Qkviews, QkviewModel{
+			Name:     types.StringValue(qkview.Filename),
+			Hostname: types.StringValue(qkview.Hostname),
+			Date:     types.StringValue(qkview.Date),
+			Size:     types.Int64Value(qkview.Size),
+		})
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s-qkviews", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &RestconfDataSource{}
+)
+
+func NewRestconfDataSource() datasource.DataSource {
+	return &RestconfDataSource{}
+}
+
+// RestconfDataSource defines the data source implementation.
+type RestconfDataSource struct {
+	client *f5ossdk.F5os
+}
+
+// RestconfDataSourceModel describes the data source data model.
+type RestconfDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Path     types.String `tfsdk:"path"`
+	Response types.String `tfsdk:"response"`
+	Found    types.Bool   `tfsdk:"found"`
+}
+
+func (d *RestconfDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restconf"
+}
+
+func (d *RestconfDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get any RESTCONF data path of the F5OS device, for the features the other data sources do not cover.\n\n" +
+			"Decode the `response` with `jsondecode` to use its attributes.",
+
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "RESTCONF data path to get, relative to the data root, for example `/openconfig-system:system/state`.",
+				Validators: []validator.String{
+					restconfPathValidator,
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"response": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "JSON subtree the device returns for `path`, `{}` when the device does not have it.",
+			},
+			"found": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Set to `true` when the device has the subtree of `path`.",
+			},
+		},
+	}
+}
+
+func (d *RestconfDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *RestconfDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RestconfDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	response, found, err := restconfGet(d.client, data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Get RESTCONF Path", fmt.Sprintf("Error:%s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("RESTCONF %s found :%+v", data.Path.ValueString(), found))
+	data.Found = types.BoolValue(found)
+	data.Response = types.StringValue("{}")
+	if found {
+		data.Response = types.StringValue(string(response))
+	}
+	data.ID = types.StringValue(data.Path.ValueString())
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,422 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RestconfResource{}
+var _ resource.ResourceWithImportState = &RestconfResource{}
+var _ resource.ResourceWithValidateConfig = &RestconfResource{}
+
+var restconfPathValidator = stringvalidator.RegexMatches(regexp.MustCompile(`^/\S+$`), "must be a RESTCONF data path starting with `/`, for example `/openconfig-system:system/config`")
+
+func NewRestconfResource() resource.Resource {
+	return &RestconfResource{}
+}
+
+// RestconfResource defines the resource implementation.
+type RestconfResource struct {
+	client *f5ossdk.F5os
+}
+
+// RestconfResourceModel describes the resource data model.
+type RestconfResourceModel struct {
+	Path         types.String `tfsdk:"path"`
+	Payload      types.String `tfsdk:"payload"`
+	CreateMethod types.String `tfsdk:"create_method"`
+	UpdateMethod types.String `tfsdk:"update_method"`
+	DeleteMethod types.String `tfsdk:"delete_method"`
+	Response     types.String `tfsdk:"response"`
+	Id           types.String `tfsdk:"id"`
+}
+
+func (r *RestconfResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restconf"
+}
+
+func (r *RestconfResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource used to manage any RESTCONF data path of F5OS systems with a JSON payload, for the features the other resources do not cover.\n\n" +
+			"The payload is compared with the subtree the device returns for the path on every refresh: the attributes of the payload that differ on the device, " +
+			"or are missing from it, show up as differences to apply again. Attributes the device returns that are not in the payload are ignored.",
+
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				MarkdownDescription: "RESTCONF data path of the managed subtree, relative to the data root, for example `/openconfig-system:system/config`.",
+				Required:            true,
+				Validators: []validator.String{
+					restconfPathValidator,
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"payload": schema.StringAttribute{
+				MarkdownDescription: "JSON payload of the subtree, in the form the device returns it for `path`, for example `jsonencode({\"openconfig-system:config\" = { hostname = \"r10900\" }})`.",
+				Required:            true,
+			},
+			"create_method": schema.StringAttribute{
+				MarkdownDescription: "Method creating the subtree, `PATCH` (default), `PUT` or `POST`.\nWith `POST` the payload is sent to the parent of `path`, as RESTCONF creates the child resources of the target.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("PATCH"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("PATCH", "PUT", "POST"),
+				},
+			},
+			"update_method": schema.StringAttribute{
+				MarkdownDescription: "Method updating the subtree, `PATCH` (default) to merge the payload or `PUT` to replace the subtree with it.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("PATCH"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("PATCH", "PUT"),
+				},
+			},
+			"delete_method": schema.StringAttribute{
+				MarkdownDescription: "Method removing the subtree when the resource is destroyed, `DELETE` (default), or `NONE` to leave the device unchanged.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("DELETE"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("DELETE", "NONE"),
+				},
+			},
+			"response": schema.StringAttribute{
+				MarkdownDescription: "JSON subtree the device returns for `path`.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RestconfResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *RestconfResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Payload.IsUnknown() || data.Payload.IsNull() {
+		return
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(data.Payload.ValueString()), &payload); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("payload"), "Invalid RESTCONF Payload", fmt.Sprintf("`payload` must be a JSON object, got error: %s", err))
+	}
+}
+
+func (r *RestconfResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *RestconfResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *RestconfResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	restconfPath := data.Path.ValueString()
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] RESTCONF %s %s", data.CreateMethod.ValueString(), restconfPath))
+
+	var err error
+	payload := []byte(data.Payload.ValueString())
+	switch data.CreateMethod.ValueString() {
+	case "POST":
+		_, err = r.client.PostRequest(restconfParentPath(restconfPath), payload)
+	case "PUT":
+		_, err = r.client.PutRequest(restconfPath, payload)
+	default:
+		_, err = r.client.PatchRequest(restconfPath, payload)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Create RESTCONF %s failed, got error: %s", restconfPath, err))
+		return
+	}
+	data.Id = types.StringValue(restconfPath)
+
+	response, found, err := restconfGet(r.client, restconfPath)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get RESTCONF %s, got error: %s", restconfPath, err))
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("RESTCONF %s is not on the device after its creation", restconfPath))
+		return
+	}
+	data.Response = types.StringValue(string(response))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RestconfResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *RestconfResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	restconfPath := data.Id.ValueString()
+	tflog.Info(ctx, fmt.Sprintf("[READ] RESTCONF %s", restconfPath))
+
+	response, found, err := restconfGet(r.client, restconfPath)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get RESTCONF %s, got error: %s", restconfPath, err))
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("RESTCONF %s no longer on the device, removing it from state", restconfPath))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.Path = types.StringValue(restconfPath)
+	data.Response = types.StringValue(string(response))
+	// imported resources take the subtree of the device as payload
+	payload := []byte(data.Payload.ValueString())
+	if data.Payload.IsNull() {
+		payload = nil
+	}
+	drifted, err := restconfDrift(payload, response)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to compare RESTCONF %s with its payload, got error: %s", restconfPath, err))
+		return
+	}
+	if drifted != nil {
+		tflog.Info(ctx, fmt.Sprintf("RESTCONF %s differs from its payload on the device: %s", restconfPath, string(drifted)))
+		data.Payload = types.StringValue(string(drifted))
+	}
+	if data.CreateMethod.IsNull() {
+		data.CreateMethod = types.StringValue("PATCH")
+		data.UpdateMethod = types.StringValue("PATCH")
+		data.DeleteMethod = types.StringValue("DELETE")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RestconfResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *RestconfResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	restconfPath := data.Path.ValueString()
+	tflog.Info(ctx, fmt.Sprintf("[UPDATE] RESTCONF %s %s", data.UpdateMethod.ValueString(), restconfPath))
+
+	var err error
+	payload := []byte(data.Payload.ValueString())
+	if data.UpdateMethod.ValueString() == "PUT" {
+		_, err = r.client.PutRequest(restconfPath, payload)
+	} else {
+		_, err = r.client.PatchRequest(restconfPath, payload)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Update RESTCONF %s failed, got error: %s", restconfPath, err))
+		return
+	}
+	response, _, err := restconfGet(r.client, restconfPath)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get RESTCONF %s, got error: %s", restconfPath, err))
+		return
+	}
+	data.Response = types.StringValue(string(response))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RestconfResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *RestconfResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.DeleteMethod.ValueString() == "NONE" {
+		tflog.Info(ctx, fmt.Sprintf("[DELETE] Leaving RESTCONF %s on the device", data.Path.ValueString()))
+		return
+	}
+	err := r.client.DeleteRequest(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to Delete RESTCONF %s, got error: %s", data.Path.ValueString(), err))
+		return
+	}
+}
+
+func (r *RestconfResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// restconfGet returns the subtree of the device at restconfPath, and whether the device has it;
+// missing subtrees are answered with an empty body or a 404.
+func restconfGet(client *f5ossdk.F5os, restconfPath string) ([]byte, bool, error) {
+	respData, err := client.GetRequest(restconfPath)
+	if isNotFound(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	subtree := make(map[string]interface{})
+	if err := json.Unmarshal(respData, &subtree); err != nil {
+		return nil, false, nil
+	}
+	if len(subtree) == 0 {
+		return nil, false, nil
+	}
+	return respData, true, nil
+}
+
+// restconfParentPath returns the path of the resource restconfPath is a child of.
+func restconfParentPath(restconfPath string) string {
+	if i := strings.LastIndex(restconfPath, "/"); i > 0 {
+		return restconfPath[:i]
+	}
+	return restconfPath
+}
+
+// restconfDrift compares payload with the response of the device and returns nil when every
+// attribute of payload has the same value on the device. Otherwise it returns the device values of
+// the attributes of payload, or the whole response when payload is nil.
+func restconfDrift(payload, response []byte) ([]byte, error) {
+	var device interface{}
+	if err := json.Unmarshal(response, &device); err != nil {
+		return nil, err
+	}
+	if payload == nil {
+		return json.Marshal(device)
+	}
+	var want interface{}
+	if err := json.Unmarshal(payload, &want); err != nil {
+		return nil, err
+	}
+	projected := restconfProject(device, want)
+	if restconfEqual(projected, want) {
+		return nil, nil
+	}
+	if projected == nil {
+		projected = map[string]interface{}{}
+	}
+	return json.Marshal(projected)
+}
+
+// restconfProject returns the attributes of device that shape has, recursively, so that the
+// attributes the device adds, such as state and defaults, are left out of the comparison.
+func restconfProject(device, shape interface{}) interface{} {
+	switch shapeVal := shape.(type) {
+	case map[string]interface{}:
+		deviceVal, ok := device.(map[string]interface{})
+		if !ok {
+			return device
+		}
+		projected := make(map[string]interface{})
+		for key, val := range shapeVal {
+			if deviceAttr, ok := deviceVal[key]; ok {
+				projected[key] = restconfProject(deviceAttr, val)
+			}
+		}
+		return projected
+	case []interface{}:
+		deviceVal, ok := device.([]interface{})
+		if !ok {
+			return device
+		}
+		// list entries are projected on the attributes of all the entries of the payload
+		var entryShape interface{}
+		for _, entry := range shapeVal {
+			entryShape = restconfMergeShape(entryShape, entry)
+		}
+		projected := make([]interface{}, 0, len(deviceVal))
+		for _, entry := range deviceVal {
+			projected = append(projected, restconfProject(entry, entryShape))
+		}
+		return projected
+	}
+	return device
+}
+
+func restconfMergeShape(a, b interface{}) interface{} {
+	aMap, aOk := a.(map[string]interface{})
+	bMap, bOk := b.(map[string]interface{})
+	if !aOk || !bOk {
+		return b
+	}
+	merged := make(map[string]interface{}, len(aMap))
+	for key, val := range aMap {
+		merged[key] = val
+	}
+	for key, val := range bMap {
+		merged[key] = restconfMergeShape(merged[key], val)
+	}
+	return merged
+}
+
+// restconfEqual compares JSON values, ignoring the order of list entries and whether numbers and
+// booleans are encoded as strings, as the device returns some of them.
+func restconfEqual(a, b interface{}) bool {
+	switch bVal := b.(type) {
+	case map[string]interface{}:
+		aVal, ok := a.(map[string]interface{})
+		if !ok || len(aVal) != len(bVal) {
+			return false
+		}
+		for key, val := range bVal {
+			if !restconfEqual(aVal[key], val) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		aVal, ok := a.([]interface{})
+		if !ok || len(aVal) != len(bVal) {
+			return false
+		}
+		matched := make([]bool, len(aVal))
+		for _, val := range bVal {
+			found := false
+			for i, entry := range aVal {
+				if !matched[i] && restconfEqual(entry, val) {
+					matched[i], found = true, true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	case nil:
+		return a == nil
+	}
+	if a == nil {
+		return false
+	}
+	return fmt.Sprint(a) == fmt.Sprint(b)
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// NewProtocol6 returns the server of the provider, which sets the log level of the resource and
+// data source operations, checks the identity of the device the resources were managed on, refuses
+// the types the platform of the device does not support and reports the checkpoint config backup
+// of a failed apply.
+func NewProtocol6(version string) func() tfprotov6.ProviderServer {
+	return func() tfprotov6.ProviderServer {
+		p := &F5osProvider{version: version}
+		return &f5osServer{ProviderServer: providerserver.NewProtocol6(p)(), provider: p}
+	}
+}
+
+// f5osServer wraps the framework server of the provider for the concerns shared by every
+// resource and data source type.
+type f5osServer struct {
+	tfprotov6.ProviderServer
+	provider *F5osProvider
+}
+
+func (s *f5osServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	diags := s.provider.checkDeviceIdentity(req.TypeName, req.Private)
+	if hasErrorDiagnostic(diags) {
+		// the state is not refreshed from another device
+		return &tfprotov6.ReadResourceResponse{NewState: req.CurrentState, Private: req.Private, Diagnostics: diags}, nil
+	}
+	resp, err := s.ProviderServer.ReadResource(s.provider.logLevelContext(ctx, req.TypeName), req)
+	if err != nil {
+		return resp, err
+	}
+	resp.Diagnostics = append(diags, resp.Diagnostics...)
+	if !hasErrorDiagnostic(resp.Diagnostics) && !isNullState(resp.NewState) {
+		resp.Private = s.provider.recordDeviceIdentity(ctx, resp.Private)
+	}
+	return resp, nil
+}
+
+func (s *f5osServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	if !isNullState(req.ProposedNewState) {
+		if diags := s.provider.checkPlatform(req.TypeName); hasErrorDiagnostic(diags) {
+			return &tfprotov6.PlanResourceChangeResponse{PlannedState: req.ProposedNewState, Diagnostics: diags}, nil
+		}
+	}
+	return s.ProviderServer.PlanResourceChange(s.provider.logLevelContext(ctx, req.TypeName), req)
+}
+
+func (s *f5osServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.ApplyResourceChange(s.provider.logLevelContext(ctx, req.TypeName), req)
+	if err != nil {
+		return resp, err
+	}
+	if hasErrorDiagnostic(resp.Diagnostics) {
+		resp.Diagnostics = append(resp.Diagnostics, s.provider.checkpointRollback()...)
+	} else if !isNullState(resp.NewState) {
+		resp.Private = s.provider.recordDeviceIdentity(ctx, resp.Private)
+	}
+	return resp, nil
+}
+
+func (s *f5osServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	resp, err := s.ProviderServer.ImportResourceState(s.provider.logLevelContext(ctx, req.TypeName), req)
+	if err != nil {
+		return resp, err
+	}
+	for _, imported := range resp.ImportedResources {
+		imported.Private = s.provider.recordDeviceIdentity(ctx, imported.Private)
+	}
+	return resp, nil
+}
+
+func (s *f5osServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	if diags := s.provider.checkPlatform(req.TypeName); hasErrorDiagnostic(diags) {
+		return &tfprotov6.ReadDataSourceResponse{Diagnostics: diags}, nil
+	}
+	return s.ProviderServer.ReadDataSource(s.provider.logLevelContext(ctx, req.TypeName), req)
+}
+
+func hasErrorDiagnostic(diags []*tfprotov6.Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SessionsClearResource{}
+var _ resource.ResourceWithImportState = &SessionsClearResource{}
+
+// sessionsClearImportUnsupported tells why f5os_sessions_clear cannot be imported.
+const sessionsClearImportUnsupported = "it terminates the sessions when it is created and leaves nothing to read on the system"
+
+func NewSessionsClearResource() resource.Resource {
+	return &SessionsClearResource{}
+}
+
+// SessionsClearResource defines the resource implementation.
+type SessionsClearResource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+type SessionsClearResourceModel struct {
+	SessionIds         types.List   `tfsdk:"session_ids"`
+	Usernames          types.List   `tfsdk:"usernames"`
+	Triggers           types.Map    `tfsdk:"triggers"`
+	TerminatedSessions types.List   `tfsdk:"terminated_sessions"`
+	Id                 types.String `tfsdk:"id"`
+}
+
+func (r *SessionsClearResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sessions_clear"
+}
+
+func (r *SessionsClearResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource to terminate management sessions on F5OS based systems, for example stale API sessions or the sessions of a user whose credentials are rotated.\n\n" +
+			"The sessions are terminated when the resource is created, change `triggers` to run it again.\n\n" +
+			"~> **NOTE** Destroying this resource only removes it from the Terraform state.\n\n" +
+			"The resource cannot be imported, " + sessionsClearImportUnsupported + ".",
+
+		Attributes: map[string]schema.Attribute{
+			"session_ids": schema.ListAttribute{
+				MarkdownDescription: "Identifiers of the sessions to terminate, as reported by the `f5os_sessions` data source.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.AtLeastOneOf(path.MatchRoot("usernames")),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"usernames": schema.ListAttribute{
+				MarkdownDescription: "Users whose sessions are all terminated.\nTerminating the sessions of the user the provider logs in with forces the provider to log in again.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will terminate the sessions again.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"terminated_sessions": schema.ListAttribute{
+				MarkdownDescription: "Identifiers of the sessions terminated by the resource.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for Sessions Clear resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SessionsClearResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+	teemData.ProviderName = "f5os"
+	teemData.ResourceName = "f5os_sessions_clear"
+	r.teemData = teemData
+}
+
+func (r *SessionsClearResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SessionsClearResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var sessionIds []int64
+	var usernames []string
+	resp.Diagnostics.Append(data.SessionIds.ElementsAs(ctx, &sessionIds, false)...)
+	resp.Diagnostics.Append(data.Usernames.ElementsAs(ctx, &usernames, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teemInfo := make(map[string]interface{})
+	teemInfo["teemData"] = r.teemData
+	err := r.client.SendTeem(teemInfo)
+	if err != nil {
+		resp.Diagnostics.AddError("Teem Error", fmt.Sprintf("Sending Teem Data failed: %s", err))
+	}
+
+	sessions, err := r.client.GetSessions()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to get Sessions, got error: %s", err))
+		return
+	}
+	terminated := []int64{}
+	for _, session := range sessions {
+		if !matchesSession(session, sessionIds, usernames) {
+			continue
+		}
+		tflog.Info(ctx, fmt.Sprintf("[CREATE] Terminating Session:%+v of user %s", session.SessionID, session.Username))
+		if err := r.client.TerminateSession(session.SessionID); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to terminate Session %d, got error: %s", session.SessionID, err))
+			break
+		}
+		terminated = append(terminated, int64(session.SessionID))
+	}
+	terminatedList, diags := types.ListValueFrom(ctx, types.Int64Type, terminated)
+	resp.Diagnostics.Append(diags...)
+	data.TerminatedSessions = terminatedList
+	data.Id = types.StringValue(fmt.Sprintf("%s-sessions-clear", r.client.Host))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SessionsClearResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SessionsClearResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Terminating sessions is a one-time action, nothing to refresh from the device.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SessionsClearResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SessionsClearResourceModel
+	var state *SessionsClearResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Every configurable attribute requires replacement, keep the results of the last run.
+	data.TerminatedSessions = state.TerminatedSessions
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SessionsClearResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SessionsClearResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[DELETE] Sessions Clear:%+v removed from state", data.Id.ValueString()))
+}
+
+func (r *SessionsClearResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importNotSupported(resp, "f5os_sessions_clear", sessionsClearImportUnsupported)
+}
+
+// matchesSession reports whether the session is selected by its identifier or user name.
+func matchesSession(session f5ossdk.F5AaaSession, sessionIds []int64, usernames []string) bool {
+	for _, id := range sessionIds {
+		if int64(session.SessionID) == id {
+			return true
+		}
+	}
+	for _, username := range usernames {
+		if session.Username == username {
+			return true
+		}
+	}
+	return false
+}
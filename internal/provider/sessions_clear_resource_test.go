@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccSessionsClearTC1Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSessionsClearConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("f5os_sessions_clear.clear", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSessionsClearUnitTC1Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	var terminated []int
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/tailf-aaa:aaa/sessions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/aaa_sessions.json"))
+	})
+	mux.HandleFunc("/restconf/data/tailf-aaa:aaa/sessions/logout", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		logout := make(map[string]map[string]int)
+		_ = json.NewDecoder(r.Body).Decode(&logout)
+		terminated = append(terminated, logout["input"]["session-id"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSessionsClearConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_sessions_clear.clear", "terminated_sessions.#", "1"),
+					resource.TestCheckResourceAttr("f5os_sessions_clear.clear", "terminated_sessions.0", "117"),
+					func(s *terraform.State) error {
+						assert.Equal(t, []int{117}, terminated, "Expected session 117 terminated, got %v", terminated)
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+const testAccSessionsClearConfig = `
+resource "f5os_sessions_clear" "clear" {
+  usernames = ["automation"]
+}
+`
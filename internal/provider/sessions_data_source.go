@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &SessionsDataSource{}
+)
+
+func NewSessionsDataSource() datasource.DataSource {
+	return &SessionsDataSource{}
+}
+
+// SessionsDataSource defines the data source implementation.
+type SessionsDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// SessionsDataSourceModel describes the data source data model.
+type SessionsDataSourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Username types.String   `tfsdk:"username"`
+	Sessions []SessionModel `tfsdk:"sessions"`
+}
+
+type SessionModel struct {
+	SessionID types.Int64  `tfsdk:"session_id"`
+	Username  types.String `tfsdk:"username"`
+	SourceIP  types.String `tfsdk:"source_ip"`
+	Protocol  types.String `tfsdk:"protocol"`
+	Context   types.String `tfsdk:"context"`
+	LoginTime types.String `tfsdk:"login_time"`
+	IdleTime  types.Int64  `tfsdk:"idle_time"`
+}
+
+func (d *SessionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sessions"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *SessionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the user and API sessions currently logged in to the F5OS device.\n\n" +
+			"Use this data source to audit who is logged in to a system before changing it.",
+
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only report the sessions of this user.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"sessions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of active sessions.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"session_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Identifier of the session on the device.",
+						},
+						"username": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "User logged in with the session.",
+						},
+						"source_ip": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Address the session was opened from.",
+						},
+						"protocol": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Protocol of the session, for example `ssh`, `https` or `rest`.",
+						},
+						"context": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Interface the session uses, for example `cli`, `webui` or `restconf`.",
+						},
+						"login_time": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Time the session logged in.",
+						},
+						"idle_time": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Seconds the session has been idle, not set when the device does not report it.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SessionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *SessionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SessionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sessions, err := d.client.GetSessions()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Get Sessions", fmt.Sprintf("Error:%s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Sessions :%+v", sessions))
+	data.Sessions = []SessionModel{}
+	for _, session := range sessions {
+		if !data.Username.IsNull() && session.Username != data.Username.ValueString() {
+			continue
+		}
+		idleTime := types.Int64Null()
+		if session.IdleTime != nil {
+			idleTime = types.Int64Value(*session.IdleTime)
+		}
+		data.Sessions = append(data.Sessions, SessionModel{
+			SessionID: types.Int64Value(int64(session.SessionID)),
+			Username:  types.StringValue(session.Username),
+			SourceIP:  types.StringValue(session.FromHost),
+			Protocol:  types.StringValue(session.Protocol),
+			Context:   types.StringValue(session.Context),
+			LoginTime: types.StringValue(session.LoginTime),
+			IdleTime:  idleTime,
+		})
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s-sessions", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccSessionsDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSessionsDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_sessions.test", "sessions.0.username"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSessionsDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/tailf-aaa:aaa/sessions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/aaa_sessions.json"))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSessionsDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_sessions.test", "sessions.#", "2"),
+					resource.TestCheckResourceAttr("data.f5os_sessions.test", "sessions.0.username", "admin"),
+					resource.TestCheckResourceAttr("data.f5os_sessions.test", "sessions.0.source_ip", "10.192.10.21"),
+					resource.TestCheckResourceAttr("data.f5os_sessions.test", "sessions.0.idle_time", "42"),
+					resource.TestCheckResourceAttr("data.f5os_sessions.test", "sessions.1.protocol", "https"),
+					resource.TestCheckNoResourceAttr("data.f5os_sessions.test", "sessions.1.idle_time"),
+				),
+			},
+			{
+				Config: testAccSessionsUserDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_sessions.test", "sessions.#", "1"),
+					resource.TestCheckResourceAttr("data.f5os_sessions.test", "sessions.0.session_id", "117"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSessionsDatasourceConfig = `
+data "f5os_sessions" "test" {}
+`
+
+const testAccSessionsUserDatasourceConfig = `
+data "f5os_sessions" "test" {
+  username = "automation"
+}
+`
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SnmpCommunityResource{}
+var _ resource.ResourceWithImportState = &SnmpCommunityResource{}
+
+func NewSnmpCommunityResource() resource.Resource {
+	return &SnmpCommunityResource{}
+}
+
+// SnmpCommunityResource defines the resource implementation.
+type SnmpCommunityResource struct {
+	client *f5ossdk.F5os
+}
+
+// SnmpCommunityResourceModel describes the resource data model.
+type SnmpCommunityResourceModel struct {
+	Name          types.String `tfsdk:"name"`
+	SecurityModel types.List   `tfsdk:"security_model"`
+	Id            types.String `tfsdk:"id"`
+}
+
+func (r *SnmpCommunityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snmp_community"
+}
+
+func (r *SnmpCommunityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource to manage the SNMPv1/v2c communities of F5OS systems, on rSeries appliances, VELOS controllers and VELOS partitions.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the community, the community string SNMP managers authenticate with.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_model": schema.ListAttribute{
+				MarkdownDescription: "SNMP versions the community is accepted for, `v1` and/or `v2c`, default is `[\"v2c\"]`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("v2c")})),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("v1", "v2c")),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnmpCommunityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *SnmpCommunityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SnmpCommunityResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "[CREATE] SNMP community")
+	r.configCommunity(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = types.StringValue(data.Name.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpCommunityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SnmpCommunityResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	communities, err := r.client.GetSnmpCommunity(data.Id.ValueString())
+	if isNotFound(err) || (err == nil && len(communities.Community) == 0) {
+		tflog.Warn(ctx, "SNMP community no longer on the system, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get SNMP community, got error: %s", err))
+		return
+	}
+	config := communities.Community[0].Config
+	data.Name = types.StringValue(config.Name)
+	var diags diag.Diagnostics
+	data.SecurityModel, diags = types.ListValueFrom(ctx, types.StringType, config.SecurityModel)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpCommunityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SnmpCommunityResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "[UPDATE] SNMP community")
+	r.configCommunity(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpCommunityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SnmpCommunityResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.DeleteSnmpCommunity(data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to Delete SNMP community, got error: %s", err))
+	}
+}
+
+func (r *SnmpCommunityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// configCommunity creates or replaces the community of data.
+func (r *SnmpCommunityResource) configCommunity(ctx context.Context, data *SnmpCommunityResourceModel, diags *diag.Diagnostics) {
+	var securityModel []string
+	diags.Append(data.SecurityModel.ElementsAs(ctx, &securityModel, false)...)
+	if diags.HasError() {
+		return
+	}
+	name := data.Name.ValueString()
+	community := &f5ossdk.F5SnmpCommunity{
+		Name:   name,
+		Config: f5ossdk.F5SnmpCommunityConfig{Name: name, SecurityModel: securityModel},
+	}
+	if _, err := r.client.SnmpCommunityConfig(community); err != nil {
+		diags.AddError("F5OS Client Error:", fmt.Sprintf("Config SNMP community failed, got error: %s", err))
+	}
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+func TestAccSnmpCommunityCreateTC1Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnmpCommunityCreateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_snmp_community.monitoring", "name", "tf-monitoring"),
+					resource.TestCheckResourceAttr("f5os_snmp_community.monitoring", "security_model.#", "1"),
+					resource.TestCheckResourceAttr("f5os_snmp_community.monitoring", "security_model.0", "v2c"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnmpCommunityCreateUnitTC1Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	communities := make(map[string]f5ossdk.F5SnmpCommunity)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	handleSnmpCommunities(t, communities)
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSnmpCommunitiesDestroyed(communities),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnmpCommunityCreateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_snmp_community.monitoring", "id", "tf-monitoring"),
+					resource.TestCheckResourceAttr("f5os_snmp_community.monitoring", "security_model.#", "1"),
+					resource.TestCheckResourceAttr("f5os_snmp_community.monitoring", "security_model.0", "v2c"),
+				),
+			},
+			{
+				Config: testAccSnmpCommunityModifyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_snmp_community.monitoring", "security_model.#", "2"),
+					resource.TestCheckResourceAttr("f5os_snmp_community.monitoring", "security_model.0", "v1"),
+					resource.TestCheckResourceAttr("f5os_snmp_community.monitoring", "security_model.1", "v2c"),
+				),
+			},
+			{
+				ResourceName:      "f5os_snmp_community.monitoring",
+				ImportState:       true,
+				ImportStateId:     "tf-monitoring",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSnmpCommunityVelosPartitionUnitTC2Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	communities := make(map[string]f5ossdk.F5SnmpCommunity)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/velos_partition_components.json"))
+	})
+	handleSnmpCommunities(t, communities)
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckSnmpCommunitiesDestroyed(communities),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnmpCommunityModifyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_snmp_community.monitoring", "id", "tf-monitoring"),
+					resource.TestCheckResourceAttr("f5os_snmp_community.monitoring", "security_model.#", "2"),
+				),
+			},
+			{
+				// the community was removed on the partition, it is created again
+				PreConfig: func() { delete(communities, "tf-monitoring") },
+				Config:    testAccSnmpCommunityModifyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						assert.Contains(t, communities, "tf-monitoring")
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// handleSnmpCommunities serves the SNMP communities of the device from communities.
+func handleSnmpCommunities(t *testing.T, communities map[string]f5ossdk.F5SnmpCommunity) {
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-snmp:snmp/communities/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/restconf/data/openconfig-system:system/f5-system-snmp:snmp/communities/community=")
+		switch r.Method {
+		case http.MethodPut:
+			body := &f5ossdk.F5RespSnmpCommunities{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(body))
+			assert.Equal(t, 1, len(body.Community), "Expected a single community, got %+v", body.Community)
+			communities[name] = body.Community[0]
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			delete(communities, name)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			community, ok := communities[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = fmt.Fprintf(w, "%s", `{"ietf-restconf:errors": {"error": [{"error-type": "application", "error-tag": "invalid-value", "error-message": "uri keypath not found"}]}}`)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(&f5ossdk.F5RespSnmpCommunities{Community: []f5ossdk.F5SnmpCommunity{community}})
+		}
+	})
+}
+
+func testAccCheckSnmpCommunitiesDestroyed(communities map[string]f5ossdk.F5SnmpCommunity) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(communities) != 0 {
+			return fmt.Errorf("SNMP communities %+v still on the system", communities)
+		}
+		return nil
+	}
+}
+
+const testAccSnmpCommunityCreateResourceConfig = `
+resource "f5os_snmp_community" "monitoring" {
+  name = "tf-monitoring"
+}
+`
+
+const testAccSnmpCommunityModifyResourceConfig = `
+resource "f5os_snmp_community" "monitoring" {
+  name           = "tf-monitoring"
+  security_model = ["v1", "v2c"]
+}
+`
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SnmpMibResource{}
+var _ resource.ResourceWithImportState = &SnmpMibResource{}
+
+func NewSnmpMibResource() resource.Resource {
+	return &SnmpMibResource{}
+}
+
+// SnmpMibResource defines the resource implementation.
+type SnmpMibResource struct {
+	client *f5ossdk.F5os
+}
+
+// SnmpMibResourceModel describes the resource data model.
+type SnmpMibResourceModel struct {
+	SysName     types.String `tfsdk:"sysname"`
+	SysContact  types.String `tfsdk:"syscontact"`
+	SysLocation types.String `tfsdk:"syslocation"`
+	Scope       types.String `tfsdk:"scope"`
+	Id          types.String `tfsdk:"id"`
+}
+
+func (r *SnmpMibResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snmp_mib"
+}
+
+func (r *SnmpMibResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource to manage the SNMPv2-MIB system objects F5OS systems report to SNMP managers, on rSeries appliances, VELOS controllers and VELOS partitions.\n\n" +
+			"~> **NOTE** The objects are system wide, only one `f5os_snmp_mib` resource should be configured per system.",
+
+		Attributes: map[string]schema.Attribute{
+			"sysname": schema.StringAttribute{
+				MarkdownDescription: "Administratively assigned name of the system, the `sysName` object.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("sysname"), path.MatchRoot("syscontact"), path.MatchRoot("syslocation")),
+				},
+			},
+			"syscontact": schema.StringAttribute{
+				MarkdownDescription: "Contact person of the system and how to reach them, the `sysContact` object.",
+				Optional:            true,
+			},
+			"syslocation": schema.StringAttribute{
+				MarkdownDescription: "Physical location of the system, the `sysLocation` object.",
+				Optional:            true,
+			},
+			"scope": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Level of the system the objects apply to, `controller` or `partition` on VELOS chassis and `appliance` on rSeries, following the address the provider connects to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnmpMibResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *SnmpMibResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SnmpMibResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	mibConfig := getSnmpMibConfig(data)
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] SNMP MIB:%+v", mibConfig.Mib.System))
+	if _, err := r.client.SnmpMibConfig(mibConfig); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Config SNMP MIB failed, got error: %s", err))
+		return
+	}
+	data.Id = types.StringValue(fmt.Sprintf("%s-snmp-mib", r.client.Host))
+	data.Scope = types.StringValue(r.client.ServiceScope())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpMibResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SnmpMibResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if serviceScopeMoved(ctx, r.client, &data.Scope, "SNMP MIB") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	mib, err := r.client.GetSnmpMib()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get SNMP MIB, got error: %s", err))
+		return
+	}
+	if *mib == (f5ossdk.F5SnmpMibSystem{}) {
+		tflog.Warn(ctx, "SNMP MIB objects no longer on the system, removing them from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.SysName = stringValueOrNull(mib.SysName)
+	data.SysContact = stringValueOrNull(mib.SysContact)
+	data.SysLocation = stringValueOrNull(mib.SysLocation)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpMibResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SnmpMibResourceModel
+	var state *SnmpMibResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	mibConfig := getSnmpMibConfig(data)
+	tflog.Info(ctx, fmt.Sprintf("[UPDATE] SNMP MIB:%+v", mibConfig.Mib.System))
+	if _, err := r.client.SnmpMibConfig(mibConfig); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Config SNMP MIB failed, got error: %s", err))
+		return
+	}
+	// a merge keeps the objects no longer configured, they are removed one by one
+	var removed []string
+	objects := []struct {
+		name       string
+		have, want types.String
+	}{
+		{"sysName", state.SysName, data.SysName},
+		{"sysContact", state.SysContact, data.SysContact},
+		{"sysLocation", state.SysLocation, data.SysLocation},
+	}
+	for _, object := range objects {
+		if !object.have.IsNull() && object.want.IsNull() {
+			removed = append(removed, object.name)
+		}
+	}
+	if err := r.client.DeleteSnmpMibObjects(removed...); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove SNMP MIB objects %v, got error: %s", removed, err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpMibResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SnmpMibResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.DeleteSnmpMib(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to Delete SNMP MIB, got error: %s", err))
+	}
+}
+
+func (r *SnmpMibResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s-snmp-mib", r.client.Host))...)
+}
+
+func getSnmpMibConfig(data *SnmpMibResourceModel) *f5ossdk.F5ReqSnmpMib {
+	mibConfig := &f5ossdk.F5ReqSnmpMib{}
+	mibConfig.Mib.System = f5ossdk.F5SnmpMibSystem{
+		SysName:     data.SysName.ValueString(),
+		SysContact:  data.SysContact.ValueString(),
+		SysLocation: data.SysLocation.ValueString(),
+	}
+	return mibConfig
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+func TestAccSnmpMibCreateTC1Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnmpMibCreateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_snmp_mib.mib", "sysname", "f5os-lab"),
+					resource.TestCheckResourceAttr("f5os_snmp_mib.mib", "syscontact", "netops@example.com"),
+					resource.TestCheckResourceAttr("f5os_snmp_mib.mib", "syslocation", "Lab rack 12"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnmpMibVelosControllerUnitTC1Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	var partition = false
+	mib := f5ossdk.F5SnmpMibSystem{}
+	var removed []string
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if partition {
+			_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/velos_partition_components.json"))
+			return
+		}
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/velos_controller_components.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-controller-image:image", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/velos_controller_image.json"))
+	})
+	mux.HandleFunc("/restconf/data/SNMPv2-MIB:SNMPv2-MIB", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body := &f5ossdk.F5ReqSnmpMib{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(body))
+		if body.Mib.System.SysName != "" {
+			mib.SysName = body.Mib.System.SysName
+		}
+		if body.Mib.System.SysContact != "" {
+			mib.SysContact = body.Mib.System.SysContact
+		}
+		if body.Mib.System.SysLocation != "" {
+			mib.SysLocation = body.Mib.System.SysLocation
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/restconf/data/SNMPv2-MIB:SNMPv2-MIB/system", func(w http.ResponseWriter, r *http.Request) {
+		if mib == (f5ossdk.F5SnmpMibSystem{}) {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&f5ossdk.F5RespSnmpMib{System: mib})
+	})
+	mux.HandleFunc("/restconf/data/SNMPv2-MIB:SNMPv2-MIB/system/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method, "Expected method 'DELETE', got %s", r.Method)
+		object := strings.TrimPrefix(r.URL.Path, "/restconf/data/SNMPv2-MIB:SNMPv2-MIB/system/")
+		removed = append(removed, object)
+		switch object {
+		case "sysName":
+			mib.SysName = ""
+		case "sysContact":
+			mib.SysContact = ""
+		case "sysLocation":
+			mib.SysLocation = ""
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnmpMibCreateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_snmp_mib.mib", "sysname", "f5os-lab"),
+					resource.TestCheckResourceAttr("f5os_snmp_mib.mib", "scope", "controller"),
+				),
+			},
+			{
+				Config: testAccSnmpMibModifyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_snmp_mib.mib", "syslocation", "Lab rack 14"),
+					resource.TestCheckNoResourceAttr("f5os_snmp_mib.mib", "syscontact"),
+					func(s *terraform.State) error {
+						assert.Equal(t, []string{"sysContact"}, removed)
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "f5os_snmp_mib.mib",
+				ImportState:       true,
+				ImportStateId:     "snmp-mib",
+				ImportStateVerify: true,
+			},
+			{
+				// the provider now connects to a chassis partition, the objects are set there
+				PreConfig: func() { partition = true },
+				Config:    testAccSnmpMibModifyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_snmp_mib.mib", "scope", "partition"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSnmpMibCreateResourceConfig = `
+resource "f5os_snmp_mib" "mib" {
+  sysname     = "f5os-lab"
+  syscontact  = "netops@example.com"
+  syslocation = "Lab rack 12"
+}
+`
+
+const testAccSnmpMibModifyResourceConfig = `
+resource "f5os_snmp_mib" "mib" {
+  sysname     = "f5os-lab"
+  syslocation = "Lab rack 14"
+}
+`
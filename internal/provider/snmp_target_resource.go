@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SnmpTargetResource{}
+var _ resource.ResourceWithImportState = &SnmpTargetResource{}
+
+func NewSnmpTargetResource() resource.Resource {
+	return &SnmpTargetResource{}
+}
+
+// SnmpTargetResource defines the resource implementation.
+type SnmpTargetResource struct {
+	client *f5ossdk.F5os
+}
+
+// SnmpTargetResourceModel describes the resource data model.
+type SnmpTargetResourceModel struct {
+	Name          types.String `tfsdk:"name"`
+	Address       types.String `tfsdk:"address"`
+	Port          types.Int64  `tfsdk:"port"`
+	Community     types.String `tfsdk:"community"`
+	SecurityModel types.String `tfsdk:"security_model"`
+	User          types.String `tfsdk:"user"`
+	Id            types.String `tfsdk:"id"`
+}
+
+func (r *SnmpTargetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snmp_target"
+}
+
+func (r *SnmpTargetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource to manage the SNMP trap targets of F5OS systems, on rSeries appliances, VELOS controllers and VELOS partitions.\n\n" +
+			"The traps are sent either with an SNMPv1/v2c `community` or as an SNMPv3 `user`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the trap target.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "IPv4 or IPv6 address of the SNMP manager receiving the traps.",
+				Required:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "UDP port of the SNMP manager, default is `162`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(162),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"community": schema.StringAttribute{
+				MarkdownDescription: "SNMPv1/v2c community the traps are sent with, requires `security_model`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("community"), path.MatchRoot("user")),
+					stringvalidator.AlsoRequires(path.MatchRoot("security_model")),
+				},
+			},
+			"security_model": schema.StringAttribute{
+				MarkdownDescription: "SNMP version of the traps sent with `community`, `v1` or `v2c`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("v1", "v2c"),
+					stringvalidator.AlsoRequires(path.MatchRoot("community")),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "SNMPv3 user the traps are sent as, for example the name of an `f5os_snmp_user`.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnmpTargetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *SnmpTargetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SnmpTargetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	target := getSnmpTargetConfig(data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] SNMP target:%s", target.Name))
+	if _, err := r.client.SnmpTargetConfig(target); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Create SNMP target failed, got error: %s", err))
+		return
+	}
+	data.Id = types.StringValue(data.Name.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpTargetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SnmpTargetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	targets, err := r.client.GetSnmpTarget(data.Id.ValueString())
+	if isNotFound(err) || (err == nil && len(targets.Target) == 0) {
+		tflog.Warn(ctx, "SNMP target no longer on the system, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get SNMP target, got error: %s", err))
+		return
+	}
+	config := targets.Target[0].Config
+	data.Name = types.StringValue(config.Name)
+	data.Community = stringValueOrNull(config.Community)
+	data.SecurityModel = stringValueOrNull(config.SecurityModel)
+	data.User = stringValueOrNull(config.User)
+	snmpAddress := config.Ipv4
+	if snmpAddress == nil {
+		snmpAddress = config.Ipv6
+	}
+	if snmpAddress != nil {
+		// the address of the state is kept when the device reports it in another form
+		have, haveErr := netip.ParseAddr(data.Address.ValueString())
+		got, gotErr := netip.ParseAddr(snmpAddress.Address)
+		if haveErr != nil || gotErr != nil || have != got {
+			data.Address = types.StringValue(snmpAddress.Address)
+		}
+		if snmpAddress.Port != 0 {
+			data.Port = types.Int64Value(int64(snmpAddress.Port))
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpTargetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SnmpTargetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	target := getSnmpTargetConfig(data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[UPDATE] SNMP target:%s", target.Name))
+	if _, err := r.client.SnmpTargetConfig(target); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Update SNMP target failed, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpTargetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SnmpTargetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.DeleteSnmpTarget(data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to Delete SNMP target, got error: %s", err))
+	}
+}
+
+func (r *SnmpTargetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func getSnmpTargetConfig(data *SnmpTargetResourceModel, diags *diag.Diagnostics) *f5ossdk.F5SnmpTarget {
+	config, err := f5ossdk.NewSnmpTargetConfig(data.Name.ValueString(), data.Address.ValueString(), int(data.Port.ValueInt64()))
+	if err != nil {
+		diags.AddError("Invalid SNMP target address", err.Error())
+		return nil
+	}
+	config.Community = data.Community.ValueString()
+	config.SecurityModel = data.SecurityModel.ValueString()
+	config.User = data.User.ValueString()
+	return &f5ossdk.F5SnmpTarget{Name: config.Name, Config: *config}
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+func TestAccSnmpTargetCreateTC1Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnmpTargetCommunityResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_snmp_target.nms", "address", "192.0.2.162"),
+					resource.TestCheckResourceAttr("f5os_snmp_target.nms", "port", "162"),
+					resource.TestCheckResourceAttr("f5os_snmp_target.nms", "security_model", "v2c"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnmpTargetCreateUnitTC1Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	targets := make(map[string]f5ossdk.F5SnmpTargetConfig)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-snmp:snmp/targets/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/restconf/data/openconfig-system:system/f5-system-snmp:snmp/targets/target=")
+		switch r.Method {
+		case http.MethodPut:
+			body := &f5ossdk.F5RespSnmpTargets{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(body))
+			targets[name] = body.Target[0].Config
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			delete(targets, name)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			config, ok := targets[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(&f5ossdk.F5RespSnmpTargets{Target: []f5ossdk.F5SnmpTarget{{Name: name, Config: config}}})
+		}
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(targets) != 0 {
+				return fmt.Errorf("SNMP targets %+v still on the system", targets)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnmpTargetCommunityResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_snmp_target.nms", "id", "tf-nms"),
+					resource.TestCheckResourceAttr("f5os_snmp_target.nms", "port", "162"),
+					func(s *terraform.State) error {
+						assert.Equal(t, &f5ossdk.F5SnmpAddress{Address: "192.0.2.162", Port: 162}, targets["tf-nms"].Ipv4)
+						return nil
+					},
+				),
+			},
+			{
+				// the target moves to an SNMPv3 user on an IPv6 manager, the community is not kept
+				Config: testAccSnmpTargetUserResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_snmp_target.nms", "address", "2001:DB8::162"),
+					resource.TestCheckResourceAttr("f5os_snmp_target.nms", "port", "10162"),
+					resource.TestCheckResourceAttr("f5os_snmp_target.nms", "user", "tf-monitoring"),
+					resource.TestCheckNoResourceAttr("f5os_snmp_target.nms", "community"),
+					func(s *terraform.State) error {
+						config := targets["tf-nms"]
+						assert.Nil(t, config.Ipv4)
+						assert.Equal(t, &f5ossdk.F5SnmpAddress{Address: "2001:db8::162", Port: 10162}, config.Ipv6)
+						assert.Empty(t, config.Community)
+						assert.Empty(t, config.SecurityModel)
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            "f5os_snmp_target.nms",
+				ImportState:             true,
+				ImportStateId:           "tf-nms",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"address"},
+			},
+		},
+	})
+}
+
+const testAccSnmpTargetCommunityResourceConfig = `
+resource "f5os_snmp_target" "nms" {
+  name           = "tf-nms"
+  address        = "192.0.2.162"
+  community      = "tf-monitoring"
+  security_model = "v2c"
+}
+`
+
+const testAccSnmpTargetUserResourceConfig = `
+resource "f5os_snmp_target" "nms" {
+  name    = "tf-nms"
+  address = "2001:DB8::162"
+  port    = 10162
+  user    = "tf-monitoring"
+}
+`
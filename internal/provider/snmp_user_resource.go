@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SnmpUserResource{}
+var _ resource.ResourceWithImportState = &SnmpUserResource{}
+
+func NewSnmpUserResource() resource.Resource {
+	return &SnmpUserResource{}
+}
+
+// SnmpUserResource defines the resource implementation.
+type SnmpUserResource struct {
+	client *f5ossdk.F5os
+}
+
+// SnmpUserResourceModel describes the resource data model.
+type SnmpUserResourceModel struct {
+	Name            types.String `tfsdk:"name"`
+	AuthProtocol    types.String `tfsdk:"auth_protocol"`
+	AuthPassword    types.String `tfsdk:"auth_password"`
+	PrivacyProtocol types.String `tfsdk:"privacy_protocol"`
+	PrivacyPassword types.String `tfsdk:"privacy_password"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func (r *SnmpUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snmp_user"
+}
+
+func (r *SnmpUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource to manage the SNMPv3 users of F5OS systems, on rSeries appliances, VELOS controllers and VELOS partitions.\n\n" +
+			"~> **NOTE** The device does not report the passwords of the users, changes made to them outside of Terraform are not detected.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the SNMPv3 user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auth_protocol": schema.StringAttribute{
+				MarkdownDescription: "Authentication protocol of the user, `md5` or `sha`, the user does not authenticate its messages when not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("md5", "sha"),
+					stringvalidator.AlsoRequires(path.MatchRoot("auth_password")),
+				},
+			},
+			"auth_password": schema.StringAttribute{
+				MarkdownDescription: "Authentication password of the user, at least 8 characters.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(8),
+					stringvalidator.AlsoRequires(path.MatchRoot("auth_protocol")),
+				},
+			},
+			"privacy_protocol": schema.StringAttribute{
+				MarkdownDescription: "Privacy protocol encrypting the messages of the user, `aes` or `des`, requires `auth_protocol`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("aes", "des"),
+					stringvalidator.AlsoRequires(path.MatchRoot("privacy_password"), path.MatchRoot("auth_protocol")),
+				},
+			},
+			"privacy_password": schema.StringAttribute{
+				MarkdownDescription: "Privacy password of the user, at least 8 characters.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(8),
+					stringvalidator.AlsoRequires(path.MatchRoot("privacy_protocol")),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SnmpUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *SnmpUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SnmpUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] SNMP user:%s", data.Name.ValueString()))
+	if _, err := r.client.SnmpUserConfig(getSnmpUserConfig(data)); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Create SNMP user failed, got error: %s", err))
+		return
+	}
+	data.Id = types.StringValue(data.Name.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SnmpUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	users, err := r.client.GetSnmpUser(data.Id.ValueString())
+	if isNotFound(err) || (err == nil && len(users.User) == 0) {
+		tflog.Warn(ctx, "SNMP user no longer on the system, removing it from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get SNMP user, got error: %s", err))
+		return
+	}
+	// the passwords are not reported, the ones of the state are kept
+	config := users.User[0].Config
+	data.Name = types.StringValue(config.Name)
+	data.AuthProtocol = stringValueOrNull(config.AuthenticationProtocol)
+	data.PrivacyProtocol = stringValueOrNull(config.PrivacyProtocol)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SnmpUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[UPDATE] SNMP user:%s", data.Name.ValueString()))
+	if _, err := r.client.SnmpUserConfig(getSnmpUserConfig(data)); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Update SNMP user failed, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SnmpUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.client.DeleteSnmpUser(data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to Delete SNMP user, got error: %s", err))
+	}
+}
+
+func (r *SnmpUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func getSnmpUserConfig(data *SnmpUserResourceModel) *f5ossdk.F5SnmpUser {
+	name := data.Name.ValueString()
+	return &f5ossdk.F5SnmpUser{
+		Name: name,
+		Config: f5ossdk.F5SnmpUserConfig{
+			Name:                   name,
+			AuthenticationProtocol: data.AuthProtocol.ValueString(),
+			AuthenticationPassword: data.AuthPassword.ValueString(),
+			PrivacyProtocol:        data.PrivacyProtocol.ValueString(),
+			PrivacyPassword:        data.PrivacyPassword.ValueString(),
+		},
+	}
+}
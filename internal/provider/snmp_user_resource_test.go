@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+func TestAccSnmpUserCreateTC1Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnmpUserPrivacyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_snmp_user.monitoring", "name", "tf-monitoring"),
+					resource.TestCheckResourceAttr("f5os_snmp_user.monitoring", "auth_protocol", "sha"),
+					resource.TestCheckResourceAttr("f5os_snmp_user.monitoring", "privacy_protocol", "aes"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnmpUserCreateUnitTC1Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	users := make(map[string]f5ossdk.F5SnmpUserConfig)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-snmp:snmp/users/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/restconf/data/openconfig-system:system/f5-system-snmp:snmp/users/user=")
+		switch r.Method {
+		case http.MethodPut:
+			body := &f5ossdk.F5RespSnmpUsers{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(body))
+			users[name] = body.User[0].Config
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			delete(users, name)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			config, ok := users[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			// the device does not report the passwords
+			config.AuthenticationPassword = ""
+			config.PrivacyPassword = ""
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(&f5ossdk.F5RespSnmpUsers{User: []f5ossdk.F5SnmpUser{{Name: name, Config: config}}})
+		}
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if len(users) != 0 {
+				return fmt.Errorf("SNMP users %+v still on the system", users)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnmpUserPrivacyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_snmp_user.monitoring", "id", "tf-monitoring"),
+					resource.TestCheckResourceAttr("f5os_snmp_user.monitoring", "privacy_protocol", "aes"),
+					resource.TestCheckResourceAttr("f5os_snmp_user.monitoring", "privacy_password", "privacy-secret"),
+					func(s *terraform.State) error {
+						assert.Equal(t, "auth-secret", users["tf-monitoring"].AuthenticationPassword)
+						assert.Equal(t, "privacy-secret", users["tf-monitoring"].PrivacyPassword)
+						return nil
+					},
+				),
+			},
+			{
+				// removing the privacy settings replaces the user without them
+				Config: testAccSnmpUserAuthResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_snmp_user.monitoring", "auth_protocol", "md5"),
+					resource.TestCheckNoResourceAttr("f5os_snmp_user.monitoring", "privacy_protocol"),
+					func(s *terraform.State) error {
+						assert.Equal(t, f5ossdk.F5SnmpUserConfig{Name: "tf-monitoring", AuthenticationProtocol: "md5", AuthenticationPassword: "auth-secret"}, users["tf-monitoring"])
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            "f5os_snmp_user.monitoring",
+				ImportState:             true,
+				ImportStateId:           "tf-monitoring",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auth_password"},
+			},
+		},
+	})
+}
+
+const testAccSnmpUserPrivacyResourceConfig = `
+resource "f5os_snmp_user" "monitoring" {
+  name             = "tf-monitoring"
+  auth_protocol    = "sha"
+  auth_password    = "auth-secret"
+  privacy_protocol = "aes"
+  privacy_password = "privacy-secret"
+}
+`
+
+const testAccSnmpUserAuthResourceConfig = `
+resource "f5os_snmp_user" "monitoring" {
+  name          = "tf-monitoring"
+  auth_protocol = "md5"
+  auth_password = "auth-secret"
+}
+`
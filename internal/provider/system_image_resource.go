@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	go_path "path"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SystemImageResource{}
+var _ resource.ResourceWithImportState = &SystemImageResource{}
+var _ resource.ResourceWithModifyPlan = &SystemImageResource{}
+
+// systemImageVersion matches the version in the name of F5OS ISO images, like 1.7.0-3518 in
+// F5OS-A-1.7.0-3518.R5R10.iso.
+var systemImageVersion = regexp.MustCompile(`\d+\.\d+\.\d+-\d+`)
+
+func NewSystemImageResource() resource.Resource {
+	return &SystemImageResource{}
+}
+
+// SystemImageResource defines the resource implementation.
+type SystemImageResource struct {
+	client *f5ossdk.F5os
+}
+
+// SystemImageResourceModel describes the resource data model.
+type SystemImageResourceModel struct {
+	ImageName      types.String `tfsdk:"image_name"`
+	Version        types.String `tfsdk:"version"`
+	UploadFromPath types.String `tfsdk:"upload_from_path"`
+	UploadChunk    types.Int64  `tfsdk:"upload_chunk_size"`
+	RemoteHost     types.String `tfsdk:"remote_host"`
+	RemotePath     types.String `tfsdk:"remote_path"`
+	Timeout        types.Int64  `tfsdk:"timeout"`
+	Id             types.String `tfsdk:"id"`
+	Status         types.String `tfsdk:"status"`
+}
+
+func (r *SystemImageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_image"
+}
+
+func (r *SystemImageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource to import the system ISO images of F5OS based systems, the OS and services versions `f5os_system_upgrade` upgrades rSeries appliances or VELOS controllers to.\n\n" +
+			"The image is transferred from a remote server or uploaded from the local machine to the `images/import/iso` directory of the system, the resource is complete once the system verified the image and reports it `ready`.",
+
+		Attributes: map[string]schema.Attribute{
+			"image_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the ISO image file, for example `F5OS-A-1.8.0-13497.R5R10.iso`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of the ISO image as reported by the system, for example `1.8.0-13497`.\nDefaults to the version in `image_name`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"upload_from_path": schema.StringAttribute{
+				MarkdownDescription: "The path to the directory of the ISO image on the local machine, to upload it rather than import it from `remote_host`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("remote_host")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"upload_chunk_size": schema.Int64Attribute{
+				MarkdownDescription: "Size in MB of the chunks the image at `upload_from_path` is uploaded in, default is `16`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"remote_host": schema.StringAttribute{
+				MarkdownDescription: "The hostname or IP address of the remote server on which the ISO image is stored.\nThe server must make the image accessible over HTTPS.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remote_path": schema.StringAttribute{
+				MarkdownDescription: "The path to the directory of the ISO image on the remote server.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("remote_host")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "The number of seconds to wait for the image to be transferred and verified, default is `1800`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1800),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of the image, `ready` once verified.",
+			},
+		},
+	}
+}
+
+func (r *SystemImageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *SystemImageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var data *SystemImageResourceModel
+	var configVersion types.String
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("version"), &configVersion)...)
+	if resp.Diagnostics.HasError() || !configVersion.IsNull() || data.ImageName.IsUnknown() {
+		return
+	}
+	version := systemImageVersion.FindString(data.ImageName.ValueString())
+	if version == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("version"), "Unknown System Image Version",
+			fmt.Sprintf("The version of %s cannot be found in its name, set `version` to the version the system reports for the image.", data.ImageName.ValueString()))
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version"), version)...)
+}
+
+func (r *SystemImageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemImageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client.PlatformType == "Velos Partition" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_system_image` resource is supported with Velos Controller level/rSeries appliance.")
+		return
+	}
+	version := data.Version.ValueString()
+	timeout := time.Duration(data.Timeout.ValueInt64()) * time.Second
+	image, err := r.client.GetSystemImage(version)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("unable to read system images, got error: %s", err))
+		return
+	}
+	if image != nil {
+		tflog.Info(ctx, fmt.Sprintf("[CREATE] System image %s already on the system, skipping transfer", version))
+	} else if data.UploadFromPath.IsNull() {
+		remoteFile := go_path.Join(data.RemotePath.ValueString(), data.ImageName.ValueString())
+		tflog.Info(ctx, fmt.Sprintf("[CREATE] Importing system image %s from %s", remoteFile, data.RemoteHost.ValueString()))
+		if err := r.client.ImportSystemImage(data.RemoteHost.ValueString(), remoteFile, timeout); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("unable to import system image, got error: %s", err))
+			return
+		}
+	} else {
+		filePath := go_path.Join(data.UploadFromPath.ValueString(), data.ImageName.ValueString())
+		tflog.Info(ctx, fmt.Sprintf("[CREATE] Uploading system image %s", filePath))
+		opts := &f5ossdk.UploadOptions{Path: f5ossdk.SystemImageImportPath}
+		if !data.UploadChunk.IsNull() {
+			opts.ChunkSize = data.UploadChunk.ValueInt64() * 1024 * 1024
+		}
+		if _, err := r.client.UploadImageChunked(filePath, opts); err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("unable to upload system image, got error: %s", err))
+			return
+		}
+	}
+	if err := r.client.WaitSystemImage(version, timeout); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("system image %s is not ready, got error: %s", version, err))
+		return
+	}
+	data.Status = types.StringValue("ready")
+	data.Id = types.StringValue(data.ImageName.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemImageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemImageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	version := data.Version.ValueString()
+	if version == "" {
+		version = systemImageVersion.FindString(data.Id.ValueString())
+	}
+	image, err := r.client.GetSystemImage(version)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("unable to read system images, got error: %s", err))
+		return
+	}
+	if image == nil {
+		tflog.Info(ctx, fmt.Sprintf("[READ] System image %s no longer on the system", version))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.Version = types.StringValue(image.Version)
+	data.Status = types.StringValue(image.Status)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemImageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemImageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemImageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SystemImageResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[DELETE] Removing system image %s", data.Version.ValueString()))
+	if err := r.client.RemoveSystemImage(data.Version.ValueString()); err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("unable to remove system image, got error: %s", err))
+	}
+}
+
+func (r *SystemImageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("image_name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), 1800)...)
+}
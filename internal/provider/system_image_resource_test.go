@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+func TestAccSystemImageCreateTC1Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSystemImageCreateTC1ResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_system_image.test", "version", "1.5.0-5781"),
+					resource.TestCheckResourceAttr("f5os_system_image.test", "status", "ready"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSystemImageCreateUnitTC1Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	imported := false
+	var importBody f5ossdk.F5ReqTenantImage
+	var removeBody map[string]string
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/rseries_platform_state_ok.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-image:image/state/install", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/rseries_platform_version.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-image:image", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(http.StatusOK)
+		if !imported {
+			_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/rseries_system_images.json"))
+			return
+		}
+		_, _ = fmt.Fprintf(w, "%s", `{"f5-system-image:image": {"iso": {"iso": [{"version": "1.5.0-5781", "status": "ready", "date": "2023-05-25"}]}}}`)
+	})
+	mux.HandleFunc("/restconf/data/f5-utils-file-transfer:file/import", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		_ = json.NewDecoder(r.Body).Decode(&importBody)
+		imported = true
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", "")
+	})
+	mux.HandleFunc("/restconf/data/f5-utils-file-transfer:file/transfer-operations/transfer-operation", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/tenant_image_transfer_status.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-image:image/remove", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		_ = json.NewDecoder(r.Body).Decode(&removeBody)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", `{"f5-system-image:output": {"response": "Successful."}}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			assert.Equal(t, map[string]string{"f5-system-image:iso": "1.5.0-5781"}, removeBody)
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSystemImageCreateTC1ResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_system_image.test", "id", "F5OS-A-1.5.0-5781.R5R10.iso"),
+					resource.TestCheckResourceAttr("f5os_system_image.test", "version", "1.5.0-5781"),
+					resource.TestCheckResourceAttr("f5os_system_image.test", "status", "ready"),
+					func(s *terraform.State) error {
+						assert.Equal(t, "images/import/iso", importBody.LocalFile)
+						assert.Equal(t, "artifactory/velocity-os-generic-release/F5OS-A/1.5.0-5781/results/R5R10/images/F5OS-A-1.5.0-5781.R5R10.iso", importBody.RemoteFile)
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            "f5os_system_image.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"remote_host", "remote_path"},
+			},
+		},
+	})
+}
+
+func TestAccSystemImageCreateUnitTC2Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSystemImageUnknownVersionResourceConfig,
+				ExpectError: regexp.MustCompile("Unknown System Image Version"),
+			},
+		},
+	})
+}
+
+const testAccSystemImageCreateTC1ResourceConfig = `
+resource "f5os_system_image" "test" {
+  image_name  = "F5OS-A-1.5.0-5781.R5R10.iso"
+  remote_host = "sea.artifactory.f5net.com"
+  remote_path = "artifactory/velocity-os-generic-release/F5OS-A/1.5.0-5781/results/R5R10/images"
+}
+`
+
+const testAccSystemImageUnknownVersionResourceConfig = `
+resource "f5os_system_image" "test" {
+  image_name  = "F5OS-A-latest.iso"
+  remote_host = "sea.artifactory.f5net.com"
+}
+`
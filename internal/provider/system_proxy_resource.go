@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SystemProxyResource{}
+var _ resource.ResourceWithImportState = &SystemProxyResource{}
+
+func NewSystemProxyResource() resource.Resource {
+	return &SystemProxyResource{}
+}
+
+// SystemProxyResource defines the resource implementation.
+type SystemProxyResource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+type SystemProxyResourceModel struct {
+	HttpsProxy types.String `tfsdk:"https_proxy"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+	Id         types.String `tfsdk:"id"`
+}
+
+func (r *SystemProxyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_proxy"
+}
+
+func (r *SystemProxyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource to manage the HTTPS proxy used by the F5OS system itself for outbound callouts such as phone-home and licensing.\n\n~> **NOTE** This is the proxy configured on the device, it is not used by the provider to connect to the F5OS platform.",
+
+		Attributes: map[string]schema.Attribute{
+			"https_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTPS proxy server used by the F5OS system, for example `http://proxy.example.com:3128`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https?://[^\s/]+(:\d+)?/?$`), "must be a valid proxy URL in the form http(s)://host[:port]"),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "User name used to authenticate with the proxy server.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password used to authenticate with the proxy server.\nThe password is not returned by the F5OS platform, changes made outside of Terraform are not detected.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for System Proxy resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SystemProxyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+	teemData.ProviderName = "f5os"
+	teemData.ResourceName = "f5os_system_proxy"
+	r.teemData = teemData
+}
+
+func (r *SystemProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemProxyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] System Proxy:%+v", data.HttpsProxy.ValueString()))
+
+	teemInfo := make(map[string]interface{})
+	teemInfo["teemData"] = r.teemData
+	err := r.client.SendTeem(teemInfo)
+	if err != nil {
+		resp.Diagnostics.AddError("Teem Error", fmt.Sprintf("Sending Teem Data failed: %s", err))
+	}
+	_, err = r.client.SystemProxyConfig(getSystemProxyConfig(data))
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Create System Proxy failed, got error: %s", err))
+		return
+	}
+	data.Id = types.StringValue("system-proxy")
+
+	proxyData, err := r.client.GetSystemProxy()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get System Proxy, got error: %s", err))
+		return
+	}
+	r.systemProxyResourceModelToState(ctx, proxyData, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemProxyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[READ] System Proxy:%+v", data.Id.ValueString()))
+	proxyData, err := r.client.GetSystemProxy()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get System Proxy, got error: %s", err))
+		return
+	}
+	if proxyData.Config.HttpsProxy == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	r.systemProxyResourceModelToState(ctx, proxyData, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemProxyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[UPDATE] System Proxy:%+v", data.HttpsProxy.ValueString()))
+
+	_, err := r.client.SystemProxyConfig(getSystemProxyConfig(data))
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Update System Proxy failed, got error: %s", err))
+		return
+	}
+	proxyData, err := r.client.GetSystemProxy()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Read/Get System Proxy, got error: %s", err))
+		return
+	}
+	r.systemProxyResourceModelToState(ctx, proxyData, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SystemProxyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.client.DeleteSystemProxy()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to Delete System Proxy, got error: %s", err))
+		return
+	}
+}
+
+func (r *SystemProxyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *SystemProxyResource) systemProxyResourceModelToState(ctx context.Context, respData *f5ossdk.F5RespSystemProxy, data *SystemProxyResourceModel) {
+	tflog.Debug(ctx, fmt.Sprintf("SystemProxyResp :%+v", respData))
+	data.HttpsProxy = types.StringValue(respData.Config.HttpsProxy)
+	if respData.Config.Username != "" {
+		data.Username = types.StringValue(respData.Config.Username)
+	}
+}
+
+func getSystemProxyConfig(data *SystemProxyResourceModel) *f5ossdk.F5ReqSystemProxy {
+	proxyReq := f5ossdk.F5ReqSystemProxy{}
+	proxyReq.Proxy.Config.HttpsProxy = data.HttpsProxy.ValueString()
+	proxyReq.Proxy.Config.Username = data.Username.ValueString()
+	proxyReq.Proxy.Config.Password = data.Password.ValueString()
+	return &proxyReq
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &SystemSettingsDataSource{}
+)
+
+func NewSystemSettingsDataSource() datasource.DataSource {
+	return &SystemSettingsDataSource{}
+}
+
+// SystemSettingsDataSource defines the data source implementation.
+type SystemSettingsDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// SystemSettingsDataSourceModel describes the data source data model.
+type SystemSettingsDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Scope             types.String `tfsdk:"scope"`
+	Hostname          types.String `tfsdk:"hostname"`
+	LoginBanner       types.String `tfsdk:"login_banner"`
+	MotdBanner        types.String `tfsdk:"motd_banner"`
+	Timezone          types.String `tfsdk:"timezone"`
+	DnsServers        types.List   `tfsdk:"dns_servers"`
+	DnsSearchDomains  types.List   `tfsdk:"dns_search_domains"`
+	NtpEnabled        types.Bool   `tfsdk:"ntp_enabled"`
+	NtpAuthentication types.Bool   `tfsdk:"ntp_authentication"`
+	NtpServers        types.List   `tfsdk:"ntp_servers"`
+}
+
+func (d *SystemSettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_settings"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *SystemSettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the hostname, banners, timezone, DNS and NTP settings of the F5OS system with a single read.\n\n" +
+			"Use this data source to pass the system settings on to the configuration of tenants, such as BIG-IP tenants inheriting the DNS and NTP servers of the system.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"scope": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Level of the system the settings are read from: `appliance` for rSeries, `controller` or `partition` for VELOS, depending on the host the provider connects to.",
+			},
+			"hostname": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Hostname of the system.",
+			},
+			"login_banner": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Banner displayed before users log in.",
+			},
+			"motd_banner": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Message of the day displayed after users log in.",
+			},
+			"timezone": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Timezone of the system clock, such as `UTC` or `Europe/Paris`.",
+			},
+			"dns_servers": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IP addresses of the DNS servers of the system.",
+			},
+			"dns_search_domains": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "DNS search domains of the system.",
+			},
+			"ntp_enabled": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the system synchronizes its clock with the NTP servers.",
+			},
+			"ntp_authentication": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether NTP authentication is enabled.",
+			},
+			"ntp_servers": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Addresses of the NTP servers of the system.",
+			},
+		},
+	}
+}
+
+func (d *SystemSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *SystemSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SystemSettingsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	settings, err := d.client.GetSystemSettings()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Unable to Read System Settings, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("System settings :%+v", settings))
+	systemSettingsToModel(ctx, settings, &data, &resp.Diagnostics)
+	data.Scope = types.StringValue(d.client.ServiceScope())
+	data.ID = types.StringValue(fmt.Sprintf("%s-system-settings", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// systemSettingsToModel sets the attributes of data from settings, the settings not configured
+// on the system being left empty.
+func systemSettingsToModel(ctx context.Context, settings *f5ossdk.F5SystemSettings, data *SystemSettingsDataSourceModel, diags *diag.Diagnostics) {
+	config := &f5ossdk.F5SystemConfig{}
+	if settings.Config != nil {
+		config = settings.Config
+	}
+	data.Hostname = stringValueOrNull(config.Hostname)
+	data.LoginBanner = stringValueOrNull(config.LoginBanner)
+	data.MotdBanner = stringValueOrNull(config.MotdBanner)
+	data.Timezone = types.StringNull()
+	if settings.Clock != nil {
+		data.Timezone = stringValueOrNull(settings.Clock.Config.TimezoneName)
+	}
+
+	dns := &f5ossdk.F5ReqDns{}
+	if settings.Dns != nil {
+		dns.Dns = *settings.Dns
+	}
+	dnsServers, dnsSearchDomains := dnsEntries(dns)
+	var d diag.Diagnostics
+	data.DnsServers, d = types.ListValueFrom(ctx, types.StringType, dnsServers)
+	diags.Append(d...)
+	data.DnsSearchDomains, d = types.ListValueFrom(ctx, types.StringType, dnsSearchDomains)
+	diags.Append(d...)
+
+	data.NtpEnabled = types.BoolValue(false)
+	data.NtpAuthentication = types.BoolValue(false)
+	ntpServers := []string{}
+	if settings.Ntp != nil {
+		if settings.Ntp.Config != nil {
+			data.NtpEnabled = types.BoolValue(settings.Ntp.Config.Enabled)
+			data.NtpAuthentication = types.BoolValue(settings.Ntp.Config.EnableNtpAuth)
+		}
+		if settings.Ntp.Servers != nil {
+			for _, server := range settings.Ntp.Servers.Server {
+				ntpServers = append(ntpServers, server.Address)
+			}
+		}
+	}
+	data.NtpServers, d = types.ListValueFrom(ctx, types.StringType, ntpServers)
+	diags.Append(d...)
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccSystemSettingsDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSystemSettingsDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_system_settings.test", "hostname"),
+					resource.TestCheckResourceAttrSet("data.f5os_system_settings.test", "ntp_enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSystemSettingsDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "config;clock;dns;ntp", r.URL.Query().Get("fields"))
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/system_settings.json"))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSystemSettingsDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_system_settings.test", "scope", "appliance"),
+					resource.TestCheckResourceAttr("data.f5os_system_settings.test", "hostname", "r10900-1.example.net"),
+					resource.TestCheckResourceAttr("data.f5os_system_settings.test", "login_banner", "Authorized use only"),
+					resource.TestCheckResourceAttr("data.f5os_system_settings.test", "motd_banner", "Maintenance window on Sundays"),
+					resource.TestCheckResourceAttr("data.f5os_system_settings.test", "timezone", "Europe/Paris"),
+					resource.TestCheckResourceAttr("data.f5os_system_settings.test", "dns_servers.#", "2"),
+					resource.TestCheckResourceAttr("data.f5os_system_settings.test", "dns_servers.1", "2001:db8::53"),
+					resource.TestCheckResourceAttr("data.f5os_system_settings.test", "dns_search_domains.0", "example.net"),
+					resource.TestCheckResourceAttr("data.f5os_system_settings.test", "ntp_enabled", "true"),
+					resource.TestCheckResourceAttr("data.f5os_system_settings.test", "ntp_authentication", "false"),
+					resource.TestCheckResourceAttr("data.f5os_system_settings.test", "ntp_servers.0", "time.example.net"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSystemSettingsDatasourceConfig = `
+data "f5os_system_settings" "test" {}
+`
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SystemUpgradeResource{}
+var _ resource.ResourceWithImportState = &SystemUpgradeResource{}
+
+func NewSystemUpgradeResource() resource.Resource {
+	return &SystemUpgradeResource{}
+}
+
+// SystemUpgradeResource defines the resource implementation.
+type SystemUpgradeResource struct {
+	client *f5ossdk.F5os
+}
+
+// SystemUpgradeResourceModel describes the resource data model.
+type SystemUpgradeResourceModel struct {
+	Version        types.String `tfsdk:"version"`
+	Timeout        types.Int64  `tfsdk:"timeout"`
+	RunningVersion types.String `tfsdk:"running_version"`
+	Id             types.String `tfsdk:"id"`
+}
+
+func (r *SystemUpgradeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_upgrade"
+}
+
+func (r *SystemUpgradeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource to upgrade the OS and services of rSeries appliances or VELOS controllers to the version of a system ISO image staged with `f5os_system_image`.\n\n" +
+			"The upgrade restarts the management plane or reboots the system, the resource waits until the system is back and runs the new version, renewing the session of the provider.\n\n" +
+			"~> **NOTE** When the system runs another version than `version`, the next apply upgrades it again. Destroying this resource only removes it from the Terraform state, the system keeps its version.",
+
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version the system is upgraded to, the version of a `ready` ISO image staged on the system, for example `1.8.0-13497`.",
+				Required:            true,
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "The number of seconds to wait for the system to run the new version, default is `3600`.\nThe system may stay unreachable for the `reboot_window` of the provider meanwhile.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(3600),
+			},
+			"running_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Version the system runs.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SystemUpgradeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (r *SystemUpgradeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemUpgradeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client.PlatformType == "Velos Partition" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_system_upgrade` resource is supported with Velos Controller level/rSeries appliance.")
+		return
+	}
+	r.upgradeSystem(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = types.StringValue(fmt.Sprintf("%s-system-upgrade", r.client.Host))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// upgradeSystem upgrades the system to the planned version, unless it already runs it.
+func (r *SystemUpgradeResource) upgradeSystem(ctx context.Context, data *SystemUpgradeResourceModel, diags *diag.Diagnostics) {
+	version := data.Version.ValueString()
+	runningVersion, installed, err := r.client.GetRunningVersion()
+	if err != nil {
+		diags.AddError("F5OS Client Error:", fmt.Sprintf("unable to read the running version, got error: %s", err))
+		return
+	}
+	if runningVersion == version && installed {
+		tflog.Info(ctx, fmt.Sprintf("System already runs %s, skipping upgrade", version))
+		data.RunningVersion = types.StringValue(runningVersion)
+		return
+	}
+	image, err := r.client.GetSystemImage(version)
+	if err != nil {
+		diags.AddError("F5OS Client Error:", fmt.Sprintf("unable to read system images, got error: %s", err))
+		return
+	}
+	if image == nil || image.Status != "ready" {
+		diags.AddAttributeError(path.Root("version"), "System Image Not Ready",
+			fmt.Sprintf("No ready ISO image of version %s is staged on the system, import it with `f5os_system_image` first.", version))
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Upgrading system from %s to %s", runningVersion, version))
+	if err := r.client.UpgradeSystem(version, time.Duration(data.Timeout.ValueInt64())*time.Second); err != nil {
+		diags.AddError("F5OS Client Error:", fmt.Sprintf("failure while upgrading the system to %s, got error: %s", version, err))
+		return
+	}
+	data.RunningVersion = types.StringValue(version)
+}
+
+func (r *SystemUpgradeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemUpgradeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	runningVersion, _, err := r.client.GetRunningVersion()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("unable to read the running version, got error: %s", err))
+		return
+	}
+	data.RunningVersion = types.StringValue(runningVersion)
+	data.Version = types.StringValue(runningVersion)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemUpgradeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemUpgradeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.upgradeSystem(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemUpgradeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SystemUpgradeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[DELETE] System Upgrade:%+v removed from state, the system keeps running %s", data.Id.ValueString(), data.RunningVersion.ValueString()))
+}
+
+func (r *SystemUpgradeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s-system-upgrade", r.client.Host))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), 3600)...)
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccSystemUpgradeTC1Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSystemUpgradeResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_system_upgrade.test", "running_version", "1.8.0-13497"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSystemUpgradeUnitTC1Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	runningVersion := "1.7.0-3518"
+	var setVersionBody map[string]string
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/rseries_platform_state_ok.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-image:image/state/install", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/rseries_platform_version.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-image:image", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		images := loadFixtureString("./fixtures/rseries_system_images.json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", regexp.MustCompile(`"install-os-version": "[^"]*"`).ReplaceAllString(images, fmt.Sprintf(`"install-os-version": "%s"`, runningVersion)))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-system:system/f5-system-image:image/set-version", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		_ = json.NewDecoder(r.Body).Decode(&setVersionBody)
+		runningVersion = setVersionBody["f5-system-image:iso-version"]
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", `{"f5-system-image:output": {"response": "System ISO version has been set"}}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSystemUpgradeNotStagedResourceConfig,
+				ExpectError: regexp.MustCompile("System Image Not Ready"),
+			},
+			{
+				Config: testAccSystemUpgradeResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_system_upgrade.test", "version", "1.8.0-13497"),
+					resource.TestCheckResourceAttr("f5os_system_upgrade.test", "running_version", "1.8.0-13497"),
+					func(s *terraform.State) error {
+						assert.Equal(t, map[string]string{"f5-system-image:iso-version": "1.8.0-13497", "f5-system-image:proceed": "yes"}, setVersionBody)
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+const testAccSystemUpgradeResourceConfig = `
+resource "f5os_system_upgrade" "test" {
+  version = "1.8.0-13497"
+}
+`
+
+const testAccSystemUpgradeNotStagedResourceConfig = `
+resource "f5os_system_upgrade" "test" {
+  version = "1.7.1-4600"
+}
+`
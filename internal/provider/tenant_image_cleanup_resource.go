@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TenantImageCleanupResource{}
+var _ resource.ResourceWithImportState = &TenantImageCleanupResource{}
+
+// imageCleanupImportUnsupported tells why f5os_tenant_image_cleanup cannot be imported.
+const imageCleanupImportUnsupported = "it removes the unused images when it is created and leaves nothing to read on the system"
+
+func NewTenantImageCleanupResource() resource.Resource {
+	return &TenantImageCleanupResource{}
+}
+
+// TenantImageCleanupResource defines the resource implementation.
+type TenantImageCleanupResource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+type TenantImageCleanupResourceModel struct {
+	KeepImages    types.List   `tfsdk:"keep_images"`
+	DryRun        types.Bool   `tfsdk:"dry_run"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+	UnusedImages  types.List   `tfsdk:"unused_images"`
+	RemovedImages types.List   `tfsdk:"removed_images"`
+	Id            types.String `tfsdk:"id"`
+}
+
+func (r *TenantImageCleanupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant_image_cleanup"
+}
+
+func (r *TenantImageCleanupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource to remove tenant images which are not used by any tenant from F5OS based systems like chassis partitions or rSeries platforms.\n\n" +
+			"The cleanup runs when the resource is created, change `triggers` to run it again.\n\n" +
+			"~> **NOTE** Destroying this resource only removes it from the Terraform state, deleted images are not restored.\n\n" +
+			"The resource cannot be imported, " + imageCleanupImportUnsupported + ".",
+
+		Attributes: map[string]schema.Attribute{
+			"keep_images": schema.ListAttribute{
+				MarkdownDescription: "List of tenant image names that must never be removed.\nShell style patterns like `BIGIP-17.1*` are supported.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"dry_run": schema.BoolAttribute{
+				MarkdownDescription: "When set to `true`, unused images are only reported in `unused_images` and nothing is removed.\nChanging it runs the cleanup again. Default value is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will run the cleanup again.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"unused_images": schema.ListAttribute{
+				MarkdownDescription: "Tenant images found not referenced by any tenant and not matched by `keep_images` when the cleanup ran.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"removed_images": schema.ListAttribute{
+				MarkdownDescription: "Tenant images removed by the cleanup.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier for Tenant Image Cleanup resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TenantImageCleanupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+	teemData.ProviderName = "f5os"
+	teemData.ResourceName = "f5os_tenant_image_cleanup"
+	r.teemData = teemData
+}
+
+func (r *TenantImageCleanupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *TenantImageCleanupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client.PlatformType == "Velos Controller" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_tenant_image_cleanup` resource is supported with Velos Partition level/rSeries appliance.")
+		return
+	}
+	var keepImages []string
+	resp.Diagnostics.Append(data.KeepImages.ElementsAs(ctx, &keepImages, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teemInfo := make(map[string]interface{})
+	teemInfo["teemData"] = r.teemData
+	err := r.client.SendTeem(teemInfo)
+	if err != nil {
+		resp.Diagnostics.AddError("Teem Error", fmt.Sprintf("Sending Teem Data failed: %s", err))
+	}
+
+	unusedImages, err := r.client.GetUnusedTenantImages()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to get unused Tenant Images, got error: %s", err))
+		return
+	}
+	var candidates []string
+	for _, image := range unusedImages {
+		if !matchesImagePatterns(image, keepImages) {
+			candidates = append(candidates, image)
+		}
+	}
+	tflog.Info(ctx, fmt.Sprintf("[CREATE] Unused Tenant Images:%+v", candidates))
+
+	removedImages := []string{}
+	if !data.DryRun.ValueBool() {
+		for _, image := range candidates {
+			tflog.Info(ctx, fmt.Sprintf("[CREATE] Removing Tenant Image:%+v", image))
+			if err := r.client.DeleteTenantImage(image); err != nil {
+				resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to remove Tenant Image %s, got error: %s", image, err))
+				break
+			}
+			removedImages = append(removedImages, image)
+		}
+	}
+	unusedList, diags := types.ListValueFrom(ctx, types.StringType, candidates)
+	resp.Diagnostics.Append(diags...)
+	removedList, diags := types.ListValueFrom(ctx, types.StringType, removedImages)
+	resp.Diagnostics.Append(diags...)
+	data.UnusedImages = unusedList
+	data.RemovedImages = removedList
+	data.Id = types.StringValue(fmt.Sprintf("%s-image-cleanup", r.client.Host))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TenantImageCleanupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TenantImageCleanupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The cleanup is a one-time action, nothing to refresh from the device.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TenantImageCleanupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *TenantImageCleanupResourceModel
+	var state *TenantImageCleanupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Every configurable attribute requires replacement, keep the results of the last run.
+	data.UnusedImages = state.UnusedImages
+	data.RemovedImages = state.RemovedImages
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TenantImageCleanupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TenantImageCleanupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[DELETE] Tenant Image Cleanup:%+v removed from state", data.Id.ValueString()))
+}
+
+func (r *TenantImageCleanupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importNotSupported(resp, "f5os_tenant_image_cleanup", imageCleanupImportUnsupported)
+}
+
+// matchesImagePatterns reports whether the image name matches any of the given names or shell patterns.
+func matchesImagePatterns(image string, patterns []string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == image {
+			return true
+		}
+		if matched, err := filepath.Match(pattern, image); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccTenantImageCleanupTC1Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTenantImageCleanupDryRunConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_tenant_image_cleanup.cleanup", "removed_images.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTenantImageCleanupUnitTC1Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	var removed []string
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/f5-tenant-images:images", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"f5-tenant-images:images": {"image": [
+  {"name": "BIGIP-17.1.0-0.0.16.ALL-F5OS.qcow2.zip.bundle", "in-use": true, "status": "replicated"},
+  {"name": "BIGIP-16.1.3.3-0.0.3.ALL-F5OS.qcow2.zip.bundle", "in-use": false, "status": "replicated"},
+  {"name": "BIGIP-15.1.8-0.0.7.ALL-F5OS.qcow2.zip.bundle", "in-use": false, "status": "replicated"},
+  {"name": "BIGIP-17.1.1-0.0.2.ALL-F5OS.qcow2.zip.bundle", "in-use": false, "status": "replicated"},
+  {"name": "BIGIP-17.0.0-0.0.22.ALL-F5OS.qcow2.zip.bundle", "in-use": false, "status": "replicated"}
+]}}`)
+	})
+	mux.HandleFunc("/restconf/data/f5-tenants:tenants", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"f5-tenants:tenants": {"tenant": [
+  {"name": "tenant1", "config": {"image": "BIGIP-17.1.0-0.0.16.ALL-F5OS.qcow2.zip.bundle"}},
+  {"name": "tenant2", "config": {"image": "BIGIP-17.0.0-0.0.22.ALL-F5OS.qcow2.zip.bundle", "running-state": "configured"}}
+]}}`)
+	})
+	mux.HandleFunc("/restconf/data/f5-tenant-images:images/remove", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		image := make(map[string]string)
+		_ = json.NewDecoder(r.Body).Decode(&image)
+		removed = append(removed, image["name"])
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/deleteImageSuccess.json"))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTenantImageCleanupConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_tenant_image_cleanup.cleanup", "unused_images.#", "2"),
+					resource.TestCheckResourceAttr("f5os_tenant_image_cleanup.cleanup", "removed_images.#", "2"),
+					resource.TestCheckResourceAttr("f5os_tenant_image_cleanup.cleanup", "removed_images.0", "BIGIP-16.1.3.3-0.0.3.ALL-F5OS.qcow2.zip.bundle"),
+					resource.TestCheckResourceAttr("f5os_tenant_image_cleanup.cleanup", "removed_images.1", "BIGIP-15.1.8-0.0.7.ALL-F5OS.qcow2.zip.bundle"),
+					func(s *terraform.State) error {
+						assert.Equal(t, 2, len(removed), "Expected 2 images removed, got %v", removed)
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+const testAccTenantImageCleanupConfig = `
+resource "f5os_tenant_image_cleanup" "cleanup" {
+  keep_images = ["BIGIP-17.1*"]
+}
+`
+
+const testAccTenantImageCleanupDryRunConfig = `
+resource "f5os_tenant_image_cleanup" "cleanup" {
+  keep_images = ["BIGIP-17.1*"]
+  dry_run     = true
+}
+`
@@ -6,12 +6,15 @@ import (
 	"encoding/json"
 	"fmt"
 	go_path "path"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -24,6 +27,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TenantImageResource{}
 var _ resource.ResourceWithImportState = &TenantImageResource{}
+var _ resource.ResourceWithValidateConfig = &TenantImageResource{}
 
 func NewTenantImageResource() resource.Resource {
 	return &TenantImageResource{}
@@ -39,6 +43,8 @@ type TenantImageResourceModel struct {
 	ImageName      types.String `tfsdk:"image_name"`
 	LocalPath      types.String `tfsdk:"local_path"`
 	UploadFromPath types.String `tfsdk:"upload_from_path"`
+	UploadChunk    types.Int64  `tfsdk:"upload_chunk_size"`
+	NameByContent  types.Bool   `tfsdk:"name_by_content"`
 	Protocol       types.String `tfsdk:"protocol"`
 	RemoteHost     types.String `tfsdk:"remote_host"`
 	RemoteUser     types.String `tfsdk:"remote_user"`
@@ -48,8 +54,14 @@ type TenantImageResourceModel struct {
 	Timeout        types.Int64  `tfsdk:"timeout"`
 	Id             types.String `tfsdk:"id"`
 	Status         types.String `tfsdk:"status"`
+	Sha256         types.String `tfsdk:"sha256"`
+	ImageId        types.String `tfsdk:"image_id"`
 }
 
+// tenantImageExtensions are the extensions kept by the names derived from the image content,
+// longest first.
+var tenantImageExtensions = []string{".qcow2.zip.bundle", ".zip.bundle", ".tar.bundle", ".iso"}
+
 func (r *TenantImageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_tenant_image"
 }
@@ -82,6 +94,21 @@ func (r *TenantImageResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringvalidator.ConflictsWith(path.MatchRoot("remote_password")),
 				},
 			},
+			"upload_chunk_size": schema.Int64Attribute{
+				MarkdownDescription: "Size in MB of the chunks the image at `upload_from_path` is uploaded in, default is `16`.\nAn upload interrupted by a failure resumes with the chunks the device did not receive yet when the resource is applied again, the progress is kept next to the image in a `.f5os-upload` file.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"name_by_content": schema.BoolAttribute{
+				MarkdownDescription: "Whether the image at `upload_from_path` is stored on the device under a name derived from its SHA-256 checksum rather than under `image_name`, default is `false`.\n" +
+					"Workspaces uploading the same file under other names then converge on a single image, the one of the first upload being adopted by the others without transferring it again. Tenants reference the image by `image_id`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
 			"protocol": schema.StringAttribute{
 				MarkdownDescription: "Protocol for image transfer.",
 				Optional:            true,
@@ -124,6 +151,20 @@ func (r *TenantImageResource) Schema(ctx context.Context, req resource.SchemaReq
 				Computed:            true,
 				MarkdownDescription: "Status of Imported Image",
 			},
+			"sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 checksum of the image file at `upload_from_path`.\nAn image of the same name already on the device is adopted without uploading it again when it was uploaded from a file with this checksum.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the image on the device, to set as `image_name` of the tenants. With `name_by_content` it is `sha256-` followed by the first 32 characters of the checksum and the extension of the image file, the same for every workspace uploading the file, otherwise it is `image_name`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -132,6 +173,19 @@ func (r *TenantImageResource) Configure(ctx context.Context, req resource.Config
 	r.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
 }
 
+func (r *TenantImageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *TenantImageResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.NameByContent.ValueBool() && data.UploadFromPath.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("name_by_content"), "Invalid Tenant Image Naming", "`name_by_content` requires the image to be uploaded from `upload_from_path`")
+	}
+}
+
 func (r *TenantImageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *TenantImageResourceModel
 
@@ -149,13 +203,30 @@ func (r *TenantImageResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	resp1Byte, _ := r.client.GetImage(data.ImageName.ValueString())
+	imageName, checksum, err := r.deviceImageName(data)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("unable to name image by its content, got error: %s", err))
+		return
+	}
+	resp1Byte, _ := r.client.GetImage(imageName)
 
 	// if err != nil {
 	// 	resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to Import Image, got error: %s", err))
 	// 	return
 	// }
 
+	data.Sha256 = types.StringNull()
+	if resp1Byte != nil && len(resp1Byte.TenantImages) > 0 && data.NameByContent.ValueBool() {
+		tflog.Info(ctx, fmt.Sprintf("Image %s with sha256 %s already on the device, skipping upload", imageName, checksum))
+		data.Sha256 = types.StringValue(checksum)
+	} else if resp1Byte != nil && len(resp1Byte.TenantImages) > 0 && !data.UploadFromPath.IsNull() {
+		checksum, err := r.adoptImage(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("unable to adopt image, got error: %s", err))
+			return
+		}
+		data.Sha256 = types.StringValue(checksum)
+	}
 	if resp1Byte == nil || len(resp1Byte.TenantImages) == 0 {
 		if data.UploadFromPath.IsNull() {
 			respByte, err := r.importImage(ctx, data)
@@ -169,7 +240,7 @@ func (r *TenantImageResource) Create(ctx context.Context, req resource.CreateReq
 			}
 
 		} else {
-			respByte, err := r.uploadImage(ctx, data)
+			respByte, checksum, err := r.uploadImage(ctx, data, imageName)
 			if err != nil {
 				resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("unable to upload image, got error: %s", err))
 				return
@@ -188,23 +259,25 @@ func (r *TenantImageResource) Create(ctx context.Context, req resource.CreateReq
 				resp.Diagnostics.AddError("F5OS Client Error:", "image upload failed")
 				return
 			}
+			data.Sha256 = types.StringValue(checksum)
 		}
 	}
 
 	// For the purposes of this example code, hardcoding a response value to
 	// save into the Terraform state.
-	respByte, err := r.client.GetImage(data.ImageName.ValueString())
+	respByte, err := r.client.GetImage(imageName)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to Read/Get Imported Image, got error: %s", err))
 		return
 	}
+	data.ImageId = types.StringValue(imageName)
 	if len(respByte.TenantImages) > 0 {
 		r.tenantImageResourceModeltoState(ctx, respByte, data)
 	} else {
 		data.Id = types.StringValue("")
 	}
 	// Save data into Terraform state
-	data.Id = types.StringValue(data.ImageName.ValueString())
+	data.Id = types.StringValue(imageName)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -220,7 +293,54 @@ func (r *TenantImageResource) importImage(ctx context.Context, data *TenantImage
 	return r.client.ImportImage(importConfig, timeout)
 }
 
-func (r *TenantImageResource) uploadImage(ctx context.Context, data *TenantImageResourceModel) ([]byte, error) {
+// adoptImage checks that the image of the same name already on the device was uploaded from the
+// file at upload_from_path, so that re-runs skip the transfer, and returns the file checksum. An
+// image the device got from elsewhere is adopted as before since its content cannot be checked.
+func (r *TenantImageResource) adoptImage(ctx context.Context, data *TenantImageResourceModel) (string, error) {
+	imageName := data.ImageName.ValueString()
+	filePath := go_path.Join(data.UploadFromPath.ValueString(), imageName)
+	checksum, err := f5ossdk.FileSHA256(filePath)
+	if err != nil {
+		return "", err
+	}
+	switch uploaded := r.client.UploadedChecksum(filePath); uploaded {
+	case checksum:
+		tflog.Info(ctx, fmt.Sprintf("Image %s with sha256 %s already on the device, skipping upload", imageName, checksum))
+	case "":
+		tflog.Warn(ctx, fmt.Sprintf("Image %s already on the device was not uploaded from %s, adopting it without checking its content", imageName, filePath))
+	default:
+		return "", fmt.Errorf("image %s on the device was uploaded with sha256 %s but %s now has sha256 %s, delete the image from the device to upload the new file", imageName, uploaded, filePath, checksum)
+	}
+	return checksum, nil
+}
+
+// deviceImageName returns the name of the image on the device and, for images named by their
+// content, the checksum of the file at upload_from_path.
+func (r *TenantImageResource) deviceImageName(data *TenantImageResourceModel) (string, string, error) {
+	if !data.NameByContent.ValueBool() {
+		return data.ImageName.ValueString(), "", nil
+	}
+	checksum, err := f5ossdk.FileSHA256(go_path.Join(data.UploadFromPath.ValueString(), data.ImageName.ValueString()))
+	if err != nil {
+		return "", "", err
+	}
+	return contentImageName(data.ImageName.ValueString(), checksum), checksum, nil
+}
+
+// contentImageName returns the name of an image with the checksum, keeping the extension of the
+// image file for the device to recognise its format.
+func contentImageName(fileName, checksum string) string {
+	ext := go_path.Ext(fileName)
+	for _, known := range tenantImageExtensions {
+		if strings.HasSuffix(fileName, known) {
+			ext = known
+			break
+		}
+	}
+	return "sha256-" + checksum[:32] + ext
+}
+
+func (r *TenantImageResource) uploadImage(ctx context.Context, data *TenantImageResourceModel, name string) ([]byte, string, error) {
 	timeout := int(data.Timeout.ValueInt64())
 	tflog.Info(ctx, fmt.Sprintf("timeout data :%+v", timeout))
 	imageDir := data.UploadFromPath.ValueString()
@@ -228,7 +348,29 @@ func (r *TenantImageResource) uploadImage(ctx context.Context, data *TenantImage
 	filePath := go_path.Join(imageDir, imageName)
 	tflog.Info(ctx, "Uploading image")
 	r.client.ConfigOptions.APICallTimeout = time.Duration(time.Duration(timeout).Seconds())
-	return r.client.UploadImage(filePath)
+	opts := &f5ossdk.UploadOptions{Name: name}
+	if !data.UploadChunk.IsNull() {
+		opts.ChunkSize = data.UploadChunk.ValueInt64() * 1024 * 1024
+	}
+	lastPercent := int64(-1)
+	opts.Progress = func(sent, total int64) {
+		// log every 5% to keep the log readable for multi-GB images
+		if percent := sent * 100 / total; percent/5 != lastPercent/5 {
+			lastPercent = percent
+			tflog.Info(ctx, fmt.Sprintf("Uploading image %s: %d%% (%d of %d bytes)", imageName, percent, sent, total))
+		}
+	}
+	result, err := r.client.UploadImageChunked(filePath, opts)
+	if err != nil {
+		return nil, "", err
+	}
+	if result.Resumed {
+		tflog.Info(ctx, fmt.Sprintf("Resumed upload of image %s", imageName))
+	}
+	tflog.Info(ctx, fmt.Sprintf("Uploaded image %s as %s, sha256 %s", imageName, name, result.SHA256))
+	// give the device time to register the image before it is read back
+	time.Sleep(10 * time.Second)
+	return result.Response, result.SHA256, nil
 }
 
 func (r *TenantImageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -244,6 +386,11 @@ func (r *TenantImageResource) Read(ctx context.Context, req resource.ReadRequest
 	// If applicable, this is a great opportunity to initialize any necessary
 	// provider client data and make a call using it.
 	respByte, err := r.client.GetImage(data.Id.ValueString())
+	if isNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Tenant image %s no longer on the device, removing it from state", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to Read/Get Imported Image, got error: %s", err))
 		return
@@ -290,7 +437,7 @@ func (r *TenantImageResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	// If applicable, this is a great opportunity to initialize any necessary
 	// provider client data and make a call using it.
-	err := r.client.DeleteTenantImage(data.ImageName.ValueString())
+	err := r.client.DeleteTenantImage(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to Delete Imported Image, got error: %s", err))
 		return
@@ -299,10 +446,15 @@ func (r *TenantImageResource) Delete(ctx context.Context, req resource.DeleteReq
 
 func (r *TenantImageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("image_name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), 360)...)
 }
 
 func (r *TenantImageResource) tenantImageResourceModeltoState(ctx context.Context, respData *f5ossdk.F5RespTenantImagesStatus, data *TenantImageResourceModel) {
 	tflog.Info(ctx, fmt.Sprintf("respData :%+v", respData))
-	data.ImageName = types.StringValue(respData.TenantImages[0].Name)
+	data.ImageId = types.StringValue(respData.TenantImages[0].Name)
+	if !data.NameByContent.ValueBool() {
+		data.ImageName = types.StringValue(respData.TenantImages[0].Name)
+	}
 	data.Status = types.StringValue(respData.TenantImages[0].Status)
 }
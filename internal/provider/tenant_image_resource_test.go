@@ -1,11 +1,16 @@
 package provider
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -192,6 +197,125 @@ func TestAccTenantImageCreateUnitTC4Resource(t *testing.T) {
 	})
 }
 
+func TestAccTenantImageRemoveNoContentUnitTC5Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	var removed = false
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/f5-tenant-images:images/image=BIGIP-17.1.0.1-0.0.4.ALL-F5OS.qcow2.zip.bundle", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", `{"f5-tenant-images:image": [{"name": "BIGIP-17.1.0.1-0.0.4.ALL-F5OS.qcow2.zip.bundle", "in-use": false, "type": "vm-image", "status": "replicated", "date": "2023-3-27", "size": "2.27 GB"}]}`)
+	})
+	mux.HandleFunc("/restconf/data/f5-utils-file-transfer:file/import", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", "")
+	})
+	mux.HandleFunc("/restconf/data/f5-utils-file-transfer:file/transfer-operations/transfer-operation", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/tenant_image_transfer_status.json"))
+	})
+	// the image is removed without an output document
+	mux.HandleFunc("/restconf/data/f5-tenant-images:images/remove", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method, "Expected method 'POST', got %s", r.Method)
+		removed = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTenantImageCreateTC2ResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_tenant_image.test", "id", "BIGIP-17.1.0.1-0.0.4.ALL-F5OS.qcow2.zip.bundle"),
+				),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if !removed {
+				return fmt.Errorf("expected the tenant image to be removed")
+			}
+			return nil
+		},
+	})
+}
+
+func TestAccTenantImageNameByContentUnitTC6Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	dir := t.TempDir()
+	content := []byte("tenant image content")
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "bigip-latest.qcow2.zip.bundle"), content, 0o644))
+	checksum := sha256.Sum256(content)
+	imageName := "sha256-" + hex.EncodeToString(checksum[:])[:32] + ".qcow2.zip.bundle"
+	var removed = false
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	// another workspace uploaded the same file under another name
+	mux.HandleFunc("/restconf/data/f5-tenant-images:images/image="+imageName, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"f5-tenant-images:image": [{"name": %q, "in-use": false, "type": "vm-image", "status": "replicated"}]}`, imageName)
+	})
+	mux.HandleFunc("/restconf/data/f5-utils-file-transfer:file/f5-file-upload-meta-data:upload/start-upload", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("expected the image named by its content to be adopted without uploading it")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/restconf/data/f5-tenant-images:images/remove", func(w http.ResponseWriter, r *http.Request) {
+		removed = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "f5os_tenant_image" "test" {
+  image_name       = "bigip-latest.qcow2.zip.bundle"
+  upload_from_path = %q
+  name_by_content  = true
+}
+`, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_tenant_image.test", "image_name", "bigip-latest.qcow2.zip.bundle"),
+					resource.TestCheckResourceAttr("f5os_tenant_image.test", "image_id", imageName),
+					resource.TestCheckResourceAttr("f5os_tenant_image.test", "id", imageName),
+					resource.TestCheckResourceAttr("f5os_tenant_image.test", "sha256", hex.EncodeToString(checksum[:])),
+				),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if !removed {
+				return fmt.Errorf("expected the tenant image to be removed")
+			}
+			return nil
+		},
+	})
+}
+
+func TestUnitTenantImageContentName(t *testing.T) {
+	checksum := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	assert.Equal(t, "sha256-9f86d081884c7d659a2feaa0c55ad015.qcow2.zip.bundle", contentImageName("BIGIP-17.1.0.1-0.0.4.ALL-F5OS.qcow2.zip.bundle", checksum))
+	assert.Equal(t, "sha256-9f86d081884c7d659a2feaa0c55ad015.tar.bundle", contentImageName("BIG-IP-Next-20.0.1-2.139.10-0.0.136-VM-F5OS.tar.bundle", checksum))
+	assert.Equal(t, "sha256-9f86d081884c7d659a2feaa0c55ad015.img", contentImageName("custom.img", checksum))
+}
+
 //func TestUnitTenantImageUpload(t *testing.T) {
 //	testAccPreUnitCheck(t)
 //	t.Logf("Server URL: %s", server.URL)
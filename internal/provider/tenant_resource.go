@@ -2,7 +2,12 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -10,10 +15,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -31,6 +39,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TenantResource{}
 var _ resource.ResourceWithImportState = &TenantResource{}
+var _ resource.ResourceWithValidateConfig = &TenantResource{}
+var _ resource.ResourceWithModifyPlan = &TenantResource{}
 
 func NewTenantResource() resource.Resource {
 	return &TenantResource{}
@@ -44,27 +54,57 @@ type TenantResource struct {
 
 // TenantResourceModel describes the resource data model.
 type TenantResourceModel struct {
-	Name                types.String `tfsdk:"name"`
-	DeploymentFile      types.String `tfsdk:"deployment_file"`
-	ImageName           types.String `tfsdk:"image_name"`
-	Cryptos             types.String `tfsdk:"cryptos"`
-	Type                types.String `tfsdk:"type"`
-	RunningState        types.String `tfsdk:"running_state"`
-	MgmtIP              types.String `tfsdk:"mgmt_ip"`
-	MgmtGateway         types.String `tfsdk:"mgmt_gateway"`
-	MgmtPrefix          types.Int64  `tfsdk:"mgmt_prefix"`
-	CpuCores            types.Int64  `tfsdk:"cpu_cores"`
-	Nodes               types.List   `tfsdk:"nodes"`
-	Vlans               types.List   `tfsdk:"vlans"`
-	Status              types.String `tfsdk:"status"`
-	MacBlockSize        types.String `tfsdk:"mac_block_size"`
-	DagIpv6prefixLength types.Int64  `tfsdk:"dag_ipv6_prefix_length"`
-	Timeout             types.Int64  `tfsdk:"timeout"`
-	VirtualdiskSize     types.Int64  `tfsdk:"virtual_disk_size"`
-	Memory              types.Int64  `tfsdk:"memory"`
-	Id                  types.String `tfsdk:"id"`
+	Name                types.String         `tfsdk:"name"`
+	DeploymentFile      types.String         `tfsdk:"deployment_file"`
+	ImageName           types.String         `tfsdk:"image_name"`
+	CloneFrom           types.String         `tfsdk:"clone_from"`
+	Cryptos             types.String         `tfsdk:"cryptos"`
+	Type                types.String         `tfsdk:"type"`
+	RunningState        types.String         `tfsdk:"running_state"`
+	MgmtIP              types.String         `tfsdk:"mgmt_ip"`
+	MgmtGateway         types.String         `tfsdk:"mgmt_gateway"`
+	MgmtPrefix          types.Int64          `tfsdk:"mgmt_prefix"`
+	CpuCores            types.Int64          `tfsdk:"cpu_cores"`
+	Nodes               types.List           `tfsdk:"nodes"`
+	PlacementPolicy     types.String         `tfsdk:"placement_policy"`
+	PlacementNodeCount  types.Int64          `tfsdk:"placement_node_count"`
+	Vlans               types.List           `tfsdk:"vlans"`
+	Status              types.String         `tfsdk:"status"`
+	MacBlockSize        types.String         `tfsdk:"mac_block_size"`
+	DagIpv6prefixLength types.Int64          `tfsdk:"dag_ipv6_prefix_length"`
+	Timeout             types.Int64          `tfsdk:"timeout"`
+	VirtualdiskSize     types.Int64          `tfsdk:"virtual_disk_size"`
+	Memory              types.Int64          `tfsdk:"memory"`
+	Hugepages           types.List           `tfsdk:"hugepages"`
+	DestroyMode         types.String         `tfsdk:"destroy_mode"`
+	DeleteImage         types.Bool           `tfsdk:"delete_image_on_destroy"`
+	WaitForRunning      types.Bool           `tfsdk:"wait_for_running"`
+	Description         types.String         `tfsdk:"description"`
+	Labels              types.Map            `tfsdk:"labels"`
+	Id                  types.String         `tfsdk:"id"`
+	Timeouts            *TenantTimeoutsModel `tfsdk:"timeouts"`
 }
 
+// TenantTimeoutsModel describes the timeouts block of the tenant resource.
+type TenantTimeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Update types.String `tfsdk:"update"`
+}
+
+// TenantHugepagesModel describes an entry of the tenant hugepages list.
+type TenantHugepagesModel struct {
+	Size  types.String `tfsdk:"size"`
+	Count types.Int64  `tfsdk:"count"`
+}
+
+var tenantHugepagesType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"size":  types.StringType,
+	"count": types.Int64Type,
+}}
+
+// tenantHugepageSizes maps the supported hugepage sizes to their size in MB.
+var tenantHugepageSizes = map[string]int64{"2M": 2, "1G": 1024}
+
 func (r *TenantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_tenant"
 }
@@ -85,8 +125,20 @@ func (r *TenantResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"image_name": schema.StringAttribute{
-				MarkdownDescription: "Name of the tenant image to be used.\nRequired for create operations",
-				Required:            true,
+				MarkdownDescription: "Name of the tenant image to be used.\nRequired for create operations, unless `clone_from` is set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"clone_from": schema.StringAttribute{
+				MarkdownDescription: "Name of an existing tenant of the system the new tenant is created from, for staged blue/green rollouts.\n" +
+					"The image, vCPUs, virtual disk size, management gateway and prefix, nodes, cryptos and type of the source tenant are used when they are not configured. " +
+					"Its VLANs, memory and hugepages are used too when not configured, but only the configured ones are managed by the resource.\n" +
+					"The source is only read when the new tenant is created, the `name` and `mgmt_ip` of the new tenant must be configured.",
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -122,8 +174,12 @@ func (r *TenantResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"cpu_cores": schema.Int64Attribute{
-				MarkdownDescription: "The number of vCPUs that should be added to the tenant.\nRequired for create operations.",
-				Required:            true,
+				MarkdownDescription: "The number of vCPUs that should be added to the tenant.\nRequired for create operations, unless `clone_from` is set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"running_state": schema.StringAttribute{
 				MarkdownDescription: "Desired running_state of the tenant.",
@@ -135,16 +191,24 @@ func (r *TenantResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Default: stringdefault.StaticString("configured"),
 			},
 			"mgmt_ip": schema.StringAttribute{
-				MarkdownDescription: "IP address used to connect to the deployed tenant.\nRequired for create operations.",
+				MarkdownDescription: "IPv4 or IPv6 address used to connect to the deployed tenant.\nRequired for create operations.",
 				Required:            true,
 			},
 			"mgmt_gateway": schema.StringAttribute{
-				MarkdownDescription: "Tenant management gateway.",
-				Required:            true,
+				MarkdownDescription: "Tenant management gateway, must be of the same address family as `mgmt_ip` and within `mgmt_prefix`.\nRequired unless `clone_from` is set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"mgmt_prefix": schema.Int64Attribute{
-				MarkdownDescription: "Tenant management CIDR prefix.",
-				Required:            true,
+				MarkdownDescription: "Tenant management CIDR prefix, up to `32` for IPv4 and `128` for IPv6 addresses.\nRequired unless `clone_from` is set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"cryptos": schema.StringAttribute{
 				MarkdownDescription: "Whether crypto and compression hardware offload should be enabled on the tenant.\nWe recommend it is enabled, otherwise crypto and compression may be processed in CPU.",
@@ -156,7 +220,7 @@ func (r *TenantResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Default: stringdefault.StaticString("enabled"),
 			},
 			"nodes": schema.ListAttribute{
-				MarkdownDescription: "List of integers. Specifies on which blades nodes the tenants are deployed.\nRequired for create operations.\nFor single blade platforms like rSeries only the value of 1 should be provided.",
+				MarkdownDescription: "List of integers. Specifies on which blades nodes the tenants are deployed.\nRequired for create operations, unless `placement_policy` chooses the blades.\nFor single blade platforms like rSeries only the value of 1 should be provided.",
 				Optional:            true,
 				Computed:            true,
 				ElementType:         types.Int64Type,
@@ -167,25 +231,103 @@ func (r *TenantResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					),
 				),
 			},
+			"placement_policy": schema.StringAttribute{
+				MarkdownDescription: "Chooses the blades of the VELOS partition the tenant is deployed on, instead of `nodes`.\n`spread` picks the running blades with the fewest vCPUs allocated to the other tenants, to balance the load, `pack` the ones with the most, to keep whole blades free for large tenants, the device still refusing blades without enough capacity left. " +
+					"The blades are chosen from the usage the device reports when the tenant is planned and kept in `nodes` afterwards, they are chosen again only when `placement_policy` or `placement_node_count` change. On single node platforms like rSeries `nodes` is `[1]`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("spread", "pack"),
+					stringvalidator.ConflictsWith(path.MatchRoot("nodes")),
+				},
+			},
+			"placement_node_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of blades `placement_policy` deploys the tenant on, default is `1`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("placement_policy")),
+				},
+			},
 			"vlans": schema.ListAttribute{
-				MarkdownDescription: "The existing VLAN IDs in the chassis partition that should be added to the tenant.\nThe order of these VLANs is ignored.\nThis module orders the VLANs automatically, if you deliberately re-order them in subsequent tasks, this module will not register a change.\nRequired for create operations",
+				MarkdownDescription: "The existing VLAN IDs in the chassis partition that should be added to the tenant.\nThe order of these VLANs is ignored.\nThis module orders the VLANs automatically, if you deliberately re-order them in subsequent tasks, this module will not register a change.\nChanging only the VLANs updates the deployed tenant in place, without redeploying it.\nRequired for create operations",
 				Optional:            true,
 				ElementType:         types.Int64Type,
 			},
 			"timeout": schema.Int64Attribute{
-				MarkdownDescription: "The number of seconds to wait for image import to finish.",
+				MarkdownDescription: "The number of seconds to wait for the tenant deployment to finish, unless the `timeouts` block sets the timeout of the operation.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(360),
 			},
 			"virtual_disk_size": schema.Int64Attribute{
-				MarkdownDescription: "Minimum virtual disk size required for Tenant deployment",
-				Required:            true,
+				MarkdownDescription: "Size of the tenant virtual disk in GB.\nThe size is checked against the disk capacity of rSeries appliances and, once the tenant is created, cannot be reduced.\nRequired unless `clone_from` is set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"memory": schema.Int64Attribute{
-				MarkdownDescription: "The amount of memory that should be provided to the tenant in MB.\n More information on memory sizing for [Velos](https://clouddocs.f5.com/training/community/velos-training/html/velos_performance_and_sizing.html#memory-sizing)/[rSeries](https://clouddocs.f5.com/training/community/rseries-training/html/rseries_performance_and_sizing.html#memory-sizing)",
+				MarkdownDescription: "The amount of memory that should be provided to the tenant in MB.\n More information on memory sizing for [Velos](https://clouddocs.f5.com/training/community/velos-training/html/velos_performance_and_sizing.html#memory-sizing)/[rSeries](https://clouddocs.f5.com/training/community/rseries-training/html/rseries_performance_and_sizing.html#memory-sizing)\nThe memory of a deployed tenant is checked against the memory available on rSeries appliances.",
+				Optional:            true,
+			},
+			"hugepages": schema.ListNestedAttribute{
+				MarkdownDescription: "Hugepages reserved for the tenant, rather than relying on the F5OS version specific defaults.\nThe memory backing the hugepages cannot exceed the tenant `memory`.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"size": schema.StringAttribute{
+							MarkdownDescription: "Hugepage size, either `2M` or `1G`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf([]string{"2M", "1G"}...),
+							},
+						},
+						"count": schema.Int64Attribute{
+							MarkdownDescription: "Number of hugepages of `size` to reserve.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"destroy_mode": schema.StringAttribute{
+				MarkdownDescription: "What destroying the resource does to the tenant, `delete` (default) removes the tenant from the system, `retain` moves it to the `configured` running state and keeps its configuration and virtual disk, to disable a tenant without losing data.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"delete", "retain"}...),
+				},
+				Default: stringdefault.StaticString("delete"),
+			},
+			"delete_image_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying the resource also removes the tenant image when no other tenant uses it.\nIgnored when `destroy_mode` is `retain`, as the retained tenant still references the image. Default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"wait_for_running": schema.BoolAttribute{
+				MarkdownDescription: "Whether applying a `deployed` tenant waits until the tenant is Running, default is `true`.\nWhen `false` the tenant is complete once it is deployed and its instances are starting.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the tenant, for example the team or the application owning it.\nChanging it updates the tenant in place, without redeploying it. The F5OS versions without tenant descriptions fail when it or `labels` is set.",
 				Optional:            true,
 			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Labels of the tenant, such as `team = \"web\"`, to select the tenants of a team with the `f5os_tenants` and `f5os_vlans` data sources.\n" +
+					"F5OS has no metadata fields, the labels are stored in the description of the tenant as `[key=value,...]` following `description`, for example `Web frontend [env=prod,team=web]`. " +
+					"Keys contain letters, digits, `_`, `.` and `-`, values also `:` and `/`. Changing them updates the tenant in place.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"status": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Tenant status",
@@ -198,7 +340,210 @@ func (r *TenantResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": schema.SingleNestedBlock{
+				MarkdownDescription: "How long to wait for the tenant to reach its running state, as durations like `30m` or `1h`.",
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						MarkdownDescription: "Timeout of the tenant creation, defaults to `timeout` seconds.",
+						Optional:            true,
+						Validators:          []validator.String{tenantTimeoutValidator},
+					},
+					"update": schema.StringAttribute{
+						MarkdownDescription: "Timeout of the tenant update, defaults to `timeout` seconds.",
+						Optional:            true,
+						Validators:          []validator.String{tenantTimeoutValidator},
+					},
+				},
+			},
+		},
+	}
+}
+
+var tenantTimeoutValidator = stringvalidator.RegexMatches(regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`), "must be a duration like `30m` or `1h30m`")
+
+// tenantWaitOptions returns how long the tenant operation waits, timeout being the
+// duration of the `timeouts` block for the operation, if any.
+func tenantWaitOptions(data *TenantResourceModel, timeout types.String) f5ossdk.TenantWaitOptions {
+	opts := f5ossdk.TenantWaitOptions{
+		Timeout:      time.Duration(data.Timeout.ValueInt64()) * time.Second,
+		DeployedOnly: !data.WaitForRunning.ValueBool(),
+	}
+	if !timeout.IsNull() && !timeout.IsUnknown() {
+		if duration, err := time.ParseDuration(timeout.ValueString()); err == nil {
+			opts.Timeout = duration
+		}
 	}
+	return opts
+}
+
+func (r *TenantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *TenantResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	validateLabels(ctx, data.Labels, "labels", &resp.Diagnostics)
+	if data.CloneFrom.IsNull() {
+		required := map[string]attr.Value{
+			"image_name":        data.ImageName,
+			"cpu_cores":         data.CpuCores,
+			"mgmt_gateway":      data.MgmtGateway,
+			"mgmt_prefix":       data.MgmtPrefix,
+			"virtual_disk_size": data.VirtualdiskSize,
+		}
+		for name, value := range required {
+			if value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root(name), "Missing Tenant Attribute", fmt.Sprintf("`%s` is required unless `clone_from` is set", name))
+			}
+		}
+	}
+	if data.MgmtIP.IsUnknown() || data.MgmtGateway.IsUnknown() || data.MgmtPrefix.IsUnknown() {
+		return
+	}
+	if data.MgmtIP.IsNull() || data.MgmtGateway.IsNull() || data.MgmtPrefix.IsNull() {
+		return
+	}
+	mgmtIP, err := netip.ParseAddr(data.MgmtIP.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("mgmt_ip"), "Invalid Tenant Management Address", fmt.Sprintf("`mgmt_ip` (%s) is not a valid IPv4 or IPv6 address", data.MgmtIP.ValueString()))
+		return
+	}
+	gateway, err := netip.ParseAddr(data.MgmtGateway.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("mgmt_gateway"), "Invalid Tenant Management Gateway", fmt.Sprintf("`mgmt_gateway` (%s) is not a valid IPv4 or IPv6 address", data.MgmtGateway.ValueString()))
+		return
+	}
+	mgmtIP, gateway = mgmtIP.Unmap(), gateway.Unmap()
+	if mgmtIP.Is4() != gateway.Is4() {
+		resp.Diagnostics.AddAttributeError(path.Root("mgmt_gateway"), "Invalid Tenant Management Gateway", fmt.Sprintf("`mgmt_gateway` (%s) and `mgmt_ip` (%s) must be of the same address family", gateway, mgmtIP))
+		return
+	}
+	prefixLen := int(data.MgmtPrefix.ValueInt64())
+	if prefixLen < 1 || prefixLen > mgmtIP.BitLen() {
+		resp.Diagnostics.AddAttributeError(path.Root("mgmt_prefix"), "Invalid Tenant Management Prefix", fmt.Sprintf("`mgmt_prefix` must be between 1 and %d for address %s, got: %d", mgmtIP.BitLen(), mgmtIP, prefixLen))
+		return
+	}
+	network := netip.PrefixFrom(mgmtIP, prefixLen).Masked()
+	if !network.Contains(gateway) {
+		resp.Diagnostics.AddAttributeError(path.Root("mgmt_gateway"), "Invalid Tenant Management Gateway", fmt.Sprintf("`mgmt_gateway` (%s) is not within the tenant management network %s", gateway, network))
+		return
+	}
+	if gateway == mgmtIP {
+		resp.Diagnostics.AddAttributeError(path.Root("mgmt_gateway"), "Invalid Tenant Management Gateway", fmt.Sprintf("`mgmt_gateway` and `mgmt_ip` must not be the same address (%s)", mgmtIP))
+		return
+	}
+	// the network and broadcast addresses of IPv4 networks cannot be assigned
+	if mgmtIP.Is4() && prefixLen <= 30 {
+		attrNames := []string{"mgmt_ip", "mgmt_gateway"}
+		for i, addr := range []netip.Addr{mgmtIP, gateway} {
+			if addr == network.Addr() || addr == ipv4Broadcast(network) {
+				resp.Diagnostics.AddAttributeError(path.Root(attrNames[i]), "Invalid Tenant Management Address", fmt.Sprintf("`%s` (%s) is the network or broadcast address of %s", attrNames[i], addr, network))
+			}
+		}
+	}
+}
+
+func (r *TenantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var data *TenantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if req.State.Raw.IsNull() && !data.CloneFrom.IsNull() && !data.CloneFrom.IsUnknown() {
+		r.planTenantClone(ctx, req, data, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !data.PlacementPolicy.IsNull() && !data.PlacementPolicy.IsUnknown() && !data.PlacementNodeCount.IsUnknown() {
+		r.planTenantPlacement(ctx, req, data, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	r.validateTenantResources(ctx, req, data, resp)
+	if data.MgmtIP.IsUnknown() {
+		return
+	}
+	hostURL, err := url.Parse(r.client.Host)
+	if err != nil {
+		return
+	}
+	hostIP, err := netip.ParseAddr(hostURL.Hostname())
+	if err != nil {
+		return
+	}
+	if mgmtIP, err := netip.ParseAddr(data.MgmtIP.ValueString()); err == nil && mgmtIP.Unmap() == hostIP.Unmap() {
+		resp.Diagnostics.AddAttributeError(path.Root("mgmt_ip"), "Invalid Tenant Management Address", fmt.Sprintf("`mgmt_ip` (%s) overlaps with the management address of the F5OS node the provider is connected to", mgmtIP))
+	}
+}
+
+// validateTenantResources checks the tenant memory, hugepages and virtual disk against each
+// other, the prior state and the resources the platform has available for tenants.
+func (r *TenantResource) validateTenantResources(ctx context.Context, req resource.ModifyPlanRequest, data *TenantResourceModel, resp *resource.ModifyPlanResponse) {
+	if data.CpuCores.IsUnknown() || data.Memory.IsUnknown() || data.Hugepages.IsUnknown() || data.VirtualdiskSize.IsUnknown() {
+		return
+	}
+	memory := int64(r.tenantMemory(ctx, data))
+	var hugepages []TenantHugepagesModel
+	resp.Diagnostics.Append(data.Hugepages.ElementsAs(ctx, &hugepages, false)...)
+	var hugepagesMemory int64
+	for _, hugepage := range hugepages {
+		hugepagesMemory += tenantHugepageSizes[hugepage.Size.ValueString()] * hugepage.Count.ValueInt64()
+	}
+	if hugepagesMemory > memory {
+		resp.Diagnostics.AddAttributeError(path.Root("hugepages"), "Invalid Tenant Hugepages", fmt.Sprintf("`hugepages` reserve %d MB, more than the %d MB of tenant memory", hugepagesMemory, memory))
+		return
+	}
+	var state *TenantResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if data.VirtualdiskSize.ValueInt64() < state.VirtualdiskSize.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(path.Root("virtual_disk_size"), "Invalid Tenant Virtual Disk Size", fmt.Sprintf("`virtual_disk_size` cannot be reduced from %d GB to %d GB", state.VirtualdiskSize.ValueInt64(), data.VirtualdiskSize.ValueInt64()))
+			return
+		}
+	}
+	if r.client.PlatformType == "Velos Partition" || r.client.PlatformType == "Velos Controller" {
+		return
+	}
+	resources, err := r.client.GetPlatformResources()
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Platform resources not available, skipping validation: %s", err))
+		return
+	}
+	if resources.DiskSize > 0 && data.VirtualdiskSize.ValueInt64() > resources.DiskSize {
+		resp.Diagnostics.AddAttributeError(path.Root("virtual_disk_size"), "Invalid Tenant Virtual Disk Size", fmt.Sprintf("`virtual_disk_size` (%d GB) exceeds the platform disk size of %d GB", data.VirtualdiskSize.ValueInt64(), resources.DiskSize))
+	}
+	// memory is only allocated to deployed tenants, the memory a deployed tenant already
+	// holds is released when it is redeployed
+	if data.RunningState.ValueString() != "deployed" || resources.MemoryAvailable == 0 {
+		return
+	}
+	available := resources.MemoryAvailable
+	if state != nil && state.RunningState.ValueString() == "deployed" {
+		available += int64(r.tenantMemory(ctx, state))
+	}
+	if memory > available {
+		resp.Diagnostics.AddAttributeError(path.Root("memory"), "Invalid Tenant Memory", fmt.Sprintf("tenant memory (%d MB) exceeds the %d MB of memory available on the platform", memory, available))
+	}
+}
+
+func ipv4Broadcast(network netip.Prefix) netip.Addr {
+	addr := network.Addr().As4()
+	for i := network.Bits(); i < 32; i++ {
+		addr[i/8] |= 1 << (7 - i%8)
+	}
+	return netip.AddrFrom4(addr)
 }
 
 func (r *TenantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -223,7 +568,7 @@ func (r *TenantResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 	if data.Type.ValueString() == "BIG-IP-Next" {
-		if data.DeploymentFile.IsNull() {
+		if data.DeploymentFile.IsNull() && data.CloneFrom.IsNull() {
 			resp.Diagnostics.AddError("Invalid Config for resource", "if `f5os_tenant` resource attribute `type` is `BIG-IP-Next`,then `deployment_file` option should also be specified")
 			return
 		}
@@ -248,8 +593,12 @@ func (r *TenantResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	tenantConfig := r.getTenantCreateConfig(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		stop <- true
+		return
+	}
 
-	if data.Type.ValueString() == "BIG-IP-Next" {
+	if data.Type.ValueString() == "BIG-IP-Next" && !data.DeploymentFile.IsNull() {
 		tenantConfig.F5TenantsTenant[0].Config.DeploymentFile = data.DeploymentFile.ValueString()
 	}
 	tflog.Info(ctx, fmt.Sprintf("tenantConfig Data:%+v", tenantConfig))
@@ -262,8 +611,18 @@ func (r *TenantResource) Create(ctx context.Context, req resource.CreateRequest,
 	// if err != nil {
 	// 	resp.Diagnostics.AddError("Teem Error", fmt.Sprintf("Sending Teem Data failed: %s", err))
 	// }
-	tflog.Info(ctx, fmt.Sprintf("Timeout :%+v", int(data.Timeout.ValueInt64())))
-	respByte, err := r.client.CreateTenant(tenantConfig, int(data.Timeout.ValueInt64()))
+	var createTimeout types.String
+	if data.Timeouts != nil {
+		createTimeout = data.Timeouts.Create
+	}
+	waitOpts := tenantWaitOptions(data, createTimeout)
+	tflog.Info(ctx, fmt.Sprintf("Timeout :%+v", waitOpts.Timeout))
+	respByte, err := r.client.CreateTenantWait(tenantConfig, waitOpts)
+	if err != nil && tenantDescriptionUnsupported(err) && tenantConfig.F5TenantsTenant[0].Config.Description != "" {
+		stop <- true
+		r.addUnsupportedLabelsError(err, &resp.Diagnostics)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("%v", err.Error()), "")
 		if strings.Contains(err.Error(), "400 Bad Request") {
@@ -310,6 +669,12 @@ func (r *TenantResource) Read(ctx context.Context, req resource.ReadRequest, res
 	//respByte, err := r.client.GetTenant(data.Name.ValueString())
 	stop := r.client.F5OsKeepAlive(15 * time.Second)
 	respByte, err := r.client.GetTenant(data.Id.ValueString())
+	if isNotFound(err) {
+		stop <- true
+		tflog.Warn(ctx, fmt.Sprintf("Tenant %s no longer on the device, removing it from state", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		stop <- true
 		resp.Diagnostics.AddError(fmt.Sprintf("%v", err.Error()), "")
@@ -331,6 +696,27 @@ func (r *TenantResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	var state *TenantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if tenantDescriptionChanged(data, state) {
+		r.setTenantDescription(ctx, data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if tenantOnlyVlansChanged(data, state) {
+		r.updateTenantVlans(ctx, data, state, resp)
+		return
+	}
+	if data.Vlans.Equal(state.Vlans) && !tenantConfigChanged(data, state) {
+		// only provider side settings such as the destroy options changed
+		data.Status = state.Status
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
 	tenantConfig := r.getTenantUpdateConfig(ctx, req, resp)
 
 	if data.Type.ValueString() == "BIG-IP-Next" {
@@ -339,7 +725,11 @@ func (r *TenantResource) Update(ctx context.Context, req resource.UpdateRequest,
 	tflog.Info(ctx, fmt.Sprintf("[Update] tenantConfig :%+v", tenantConfig))
 	// mutex.Lock()
 	stop := r.client.F5OsKeepAlive(15 * time.Second)
-	respByte, err := r.client.UpdateTenant(tenantConfig, int(data.Timeout.ValueInt64()))
+	var updateTimeout types.String
+	if data.Timeouts != nil {
+		updateTimeout = data.Timeouts.Update
+	}
+	respByte, err := r.client.UpdateTenantWait(tenantConfig, tenantWaitOptions(data, updateTimeout))
 	if err != nil {
 		stop <- true
 		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Tenant Deploy failed, got error: %s", err))
@@ -361,6 +751,130 @@ func (r *TenantResource) Update(ctx context.Context, req resource.UpdateRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// updateTenantVlans adds and removes tenant vlans in place, leaving the
+// deployed tenant untouched.
+func (r *TenantResource) updateTenantVlans(ctx context.Context, data, state *TenantResourceModel, resp *resource.UpdateResponse) {
+	var planVlans, stateVlans []int
+	data.Vlans.ElementsAs(ctx, &planVlans, false)
+	state.Vlans.ElementsAs(ctx, &stateVlans, false)
+	addVlans := intListDifference(planVlans, stateVlans)
+	removeVlans := intListDifference(stateVlans, planVlans)
+	tflog.Info(ctx, fmt.Sprintf("[Update] tenant vlans add:%+v remove:%+v", addVlans, removeVlans))
+	if len(addVlans) > 0 {
+		err := r.client.AddTenantVlans(data.Name.ValueString(), addVlans)
+		if err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Tenant VLAN update failed, got error: %s", err))
+			return
+		}
+	}
+	for _, vlanId := range removeVlans {
+		err := r.client.RemoveTenantVlan(data.Name.ValueString(), vlanId)
+		if err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Tenant VLAN (%d) removal failed, got error: %s", vlanId, err))
+			return
+		}
+	}
+	respByte, err := r.client.GetTenant(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("%v", err.Error()), "")
+		return
+	}
+	r.tenantResourceModeltoState(ctx, respByte, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// tenantOnlyVlansChanged reports whether vlans is the only tenant attribute
+// that differs between plan and state; unknown plan values count as unchanged.
+func tenantOnlyVlansChanged(plan, state *TenantResourceModel) bool {
+	if plan.Vlans.Equal(state.Vlans) {
+		return false
+	}
+	return !tenantConfigChanged(plan, state)
+}
+
+// tenantConfigChanged reports whether a tenant attribute deployed to the device,
+// other than vlans, differs between plan and state.
+func tenantConfigChanged(plan, state *TenantResourceModel) bool {
+	pairs := [][2]attr.Value{
+		{plan.Name, state.Name},
+		{plan.DeploymentFile, state.DeploymentFile},
+		{plan.ImageName, state.ImageName},
+		{plan.Cryptos, state.Cryptos},
+		{plan.Type, state.Type},
+		{plan.RunningState, state.RunningState},
+		{plan.MgmtIP, state.MgmtIP},
+		{plan.MgmtGateway, state.MgmtGateway},
+		{plan.MgmtPrefix, state.MgmtPrefix},
+		{plan.CpuCores, state.CpuCores},
+		{plan.Nodes, state.Nodes},
+		{plan.MacBlockSize, state.MacBlockSize},
+		{plan.DagIpv6prefixLength, state.DagIpv6prefixLength},
+		{plan.VirtualdiskSize, state.VirtualdiskSize},
+		{plan.Memory, state.Memory},
+		{plan.Hugepages, state.Hugepages},
+	}
+	for _, pair := range pairs {
+		if !pair[0].IsUnknown() && !pair[0].Equal(pair[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// tenantDescriptionChanged reports whether the description stored on the tenant, its text and
+// labels, differs between plan and state.
+func tenantDescriptionChanged(plan, state *TenantResourceModel) bool {
+	return !plan.Description.Equal(state.Description) || !plan.Labels.Equal(state.Labels)
+}
+
+// setTenantDescription stores the description and labels of data on the tenant in place.
+func (r *TenantResource) setTenantDescription(ctx context.Context, data *TenantResourceModel, diags *diag.Diagnostics) {
+	description := tenantDescription(ctx, data, diags)
+	if diags.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[Update] tenant description:%+v", description))
+	err := r.client.SetTenantDescription(data.Name.ValueString(), description)
+	if tenantDescriptionUnsupported(err) {
+		r.addUnsupportedLabelsError(err, diags)
+		return
+	}
+	if err != nil {
+		diags.AddError("F5OS Client Error:", fmt.Sprintf("Tenant description update failed, got error: %s", err))
+	}
+}
+
+// tenantDescription returns the description of the tenant storing its description and labels.
+func tenantDescription(ctx context.Context, data *TenantResourceModel, diags *diag.Diagnostics) string {
+	return encodeLabels(data.Description.ValueString(), labelsMap(ctx, data.Labels, diags))
+}
+
+func (r *TenantResource) addUnsupportedLabelsError(err error, diags *diag.Diagnostics) {
+	diags.AddError("Unsupported Tenant Labels",
+		fmt.Sprintf("F5OS %s %s does not support tenant descriptions, `description` and `labels` cannot be set: %s", r.client.PlatformType, r.client.PlatformVersion, err))
+}
+
+// tenantDescriptionUnsupported reports whether err is the answer of the F5OS versions without
+// tenant descriptions.
+func tenantDescriptionUnsupported(err error) bool {
+	var validationErr *f5ossdk.ValidationError
+	return errors.As(err, &validationErr) && validationErr.HasErrorTag("unknown-element")
+}
+
+func intListDifference(a, b []int) []int {
+	seen := make(map[int]bool, len(b))
+	for _, val := range b {
+		seen[val] = true
+	}
+	var diff []int
+	for _, val := range a {
+		if !seen[val] {
+			diff = append(diff, val)
+		}
+	}
+	return diff
+}
+
 func (r *TenantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data *TenantResourceModel
 
@@ -370,6 +884,18 @@ func (r *TenantResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if data.DestroyMode.ValueString() == "retain" {
+		tflog.Info(ctx, fmt.Sprintf("[DELETE] Retaining tenant %s in configured state", data.Name.ValueString()))
+		err := r.client.SetTenantRunningState(data.Name.ValueString(), "configured")
+		if err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Moving tenant to configured state failed, got error: %s", err))
+			return
+		}
+		if data.DeleteImage.ValueBool() {
+			resp.Diagnostics.AddWarning("Tenant Image Retained", fmt.Sprintf("Tenant image %s is not removed, the retained tenant %s still references it", data.ImageName.ValueString(), data.Name.ValueString()))
+		}
+		return
+	}
 	stop := r.client.F5OsKeepAlive(15 * time.Second)
 	err := r.client.DeleteTenant(data.Name.ValueString())
 	stop <- true
@@ -377,10 +903,207 @@ func (r *TenantResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		resp.Diagnostics.AddError(fmt.Sprintf("%v", err.Error()), "")
 		return
 	}
+	if data.DeleteImage.ValueBool() {
+		r.deleteTenantImage(ctx, data.ImageName.ValueString(), resp)
+	}
+}
+
+// deleteTenantImage removes the image of a destroyed tenant unless another tenant still uses it.
+func (r *TenantResource) deleteTenantImage(ctx context.Context, imageName string, resp *resource.DeleteResponse) {
+	unused, err := r.client.GetUnusedTenantImages()
+	if err != nil {
+		resp.Diagnostics.AddWarning("Tenant Image Not Removed", fmt.Sprintf("Unable to check the usage of tenant image %s, got error: %s", imageName, err))
+		return
+	}
+	for _, image := range unused {
+		if image != imageName {
+			continue
+		}
+		tflog.Info(ctx, fmt.Sprintf("[DELETE] Removing tenant image %s", imageName))
+		if err := r.client.DeleteTenantImage(imageName); err != nil {
+			resp.Diagnostics.AddWarning("Tenant Image Not Removed", fmt.Sprintf("Removing tenant image %s failed, got error: %s", imageName, err))
+		}
+		return
+	}
+	resp.Diagnostics.AddWarning("Tenant Image Not Removed", fmt.Sprintf("Tenant image %s is still in use or no longer present", imageName))
+}
+
+// planTenantClone plans the attributes of the new tenant that are not configured with the
+// configuration of the clone_from tenant.
+func (r *TenantResource) planTenantClone(ctx context.Context, req resource.ModifyPlanRequest, data *TenantResourceModel, resp *resource.ModifyPlanResponse) {
+	var config *TenantResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	source, err := r.tenantCloneSource(data.CloneFrom.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("clone_from"), "Invalid Tenant Clone Source", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[PLAN] Tenant %s cloned from %s", data.Name.ValueString(), source.Name))
+	if config.ImageName.IsNull() {
+		data.ImageName = types.StringValue(source.Config.Image)
+	}
+	if config.CpuCores.IsNull() {
+		data.CpuCores = types.Int64Value(int64(source.Config.VcpuCoresPerNode))
+	}
+	if config.VirtualdiskSize.IsNull() {
+		data.VirtualdiskSize = types.Int64Value(int64(source.Config.Storage.Size))
+	}
+	if config.MgmtGateway.IsNull() {
+		data.MgmtGateway = types.StringValue(source.Config.Gateway)
+	}
+	if config.MgmtPrefix.IsNull() {
+		data.MgmtPrefix = types.Int64Value(int64(source.Config.PrefixLength))
+	}
+	if config.Cryptos.IsNull() && source.Config.Cryptos != "" {
+		data.Cryptos = types.StringValue(source.Config.Cryptos)
+	}
+	if config.Type.IsNull() && source.Config.Type != "" {
+		data.Type = types.StringValue(source.Config.Type)
+	}
+	if config.Nodes.IsNull() && len(source.Config.Nodes) > 0 {
+		var diags diag.Diagnostics
+		data.Nodes, diags = types.ListValueFrom(ctx, types.Int64Type, source.Config.Nodes)
+		resp.Diagnostics.Append(diags...)
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, data)...)
+}
+
+// planTenantPlacement plans the nodes of a tenant placed by placement_policy, the blades being
+// chosen again only when the policy or the number of blades changes.
+func (r *TenantResource) planTenantPlacement(ctx context.Context, req resource.ModifyPlanRequest, data *TenantResourceModel, resp *resource.ModifyPlanResponse) {
+	if !req.State.Raw.IsNull() {
+		var state *TenantResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.PlacementPolicy.Equal(data.PlacementPolicy) && state.PlacementNodeCount.Equal(data.PlacementNodeCount) {
+			data.Nodes = state.Nodes
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, data)...)
+			return
+		}
+	}
+	count := 1
+	if !data.PlacementNodeCount.IsNull() {
+		count = int(data.PlacementNodeCount.ValueInt64())
+	}
+	nodes := []int{1}
+	if r.client.PlatformType == "Velos Partition" {
+		usage, err := r.client.GetTenantNodeUsage(data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("placement_policy"), "Tenant Placement Failed", fmt.Sprintf("Unable to read the usage of the blades, got error: %s", err))
+			return
+		}
+		if nodes, err = tenantPlacementNodes(usage, data.PlacementPolicy.ValueString(), count); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("placement_node_count"), "Tenant Placement Failed", err.Error())
+			return
+		}
+	} else if count > 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("placement_node_count"), "Tenant Placement Failed", fmt.Sprintf("%s has a single node, got %d nodes to place the tenant on", r.client.PlatformType, count))
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("[PLAN] Tenant %s placed on nodes %v by the %s policy", data.Name.ValueString(), nodes, data.PlacementPolicy.ValueString()))
+	var diags diag.Diagnostics
+	data.Nodes, diags = types.ListValueFrom(ctx, types.Int64Type, nodes)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, data)...)
+}
+
+// tenantPlacementNodes returns the count blades chosen by policy among the running blades of
+// usage, ordered by slot number. Ties are broken by the number of tenants, then by slot number.
+func tenantPlacementNodes(usage []f5ossdk.F5NodeUsage, policy string, count int) ([]int, error) {
+	if count > len(usage) {
+		return nil, fmt.Errorf("the tenant is to be placed on %d blades, the partition has %d running blades", count, len(usage))
+	}
+	candidates := append([]f5ossdk.F5NodeUsage(nil), usage...)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if policy == "pack" {
+			a, b = b, a
+		}
+		if a.VcpuCores != b.VcpuCores {
+			return a.VcpuCores < b.VcpuCores
+		}
+		if a.Tenants != b.Tenants {
+			return a.Tenants < b.Tenants
+		}
+		return candidates[i].Node < candidates[j].Node
+	})
+	nodes := make([]int, 0, count)
+	for _, candidate := range candidates[:count] {
+		nodes = append(nodes, candidate.Node)
+	}
+	sort.Ints(nodes)
+	return nodes, nil
+}
+
+// tenantRespMemory returns the memory of a tenant in MB, F5OS reports it as a string.
+func tenantRespMemory(tenant *f5ossdk.F5RespTenant) int {
+	memory, _ := strconv.Atoi(tenant.State.Memory)
+	return memory
+}
+
+// tenantCloneSource returns the tenant a new tenant is cloned from.
+func (r *TenantResource) tenantCloneSource(name string) (*f5ossdk.F5RespTenant, error) {
+	respByte, err := r.client.GetTenant(name)
+	if isNotFound(err) {
+		return nil, fmt.Errorf("tenant %s to clone does not exist", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read tenant %s to clone, got error: %s", name, err)
+	}
+	return &respByte.F5TenantsTenant[0], nil
 }
 
 func (r *TenantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), 360)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destroy_mode"), "delete")...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_image_on_destroy"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_running"), true)...)
+
+	// Read only refreshes the optional attributes already in state, the ones configured on
+	// the tenant are seeded here
+	respByte, err := r.client.GetTenant(req.ID)
+	if isNotFound(err) {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Import Tenant, tenant %s not found", req.ID))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error", fmt.Sprintf("Unable to Import Tenant, got error: %s", err))
+		return
+	}
+	config := respByte.F5TenantsTenant[0].Config
+	tenantType := config.Type
+	if tenantType == "" {
+		tenantType = "BIG-IP"
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), tenantType)...)
+	if config.DeploymentFile != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_file"), config.DeploymentFile)...)
+	}
+	if len(config.Vlans) > 0 {
+		vlans := append([]int{}, config.Vlans...)
+		sort.Ints(vlans)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vlans"), vlans)...)
+	}
+	if memory := tenantRespMemory(&respByte.F5TenantsTenant[0]); memory > 0 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("memory"), memory)...)
+	}
+	if len(config.Hugepages) > 0 {
+		var hugepages []TenantHugepagesModel
+		for _, hugepage := range config.Hugepages {
+			hugepages = append(hugepages, TenantHugepagesModel{
+				Size:  types.StringValue(hugepage.Size),
+				Count: types.Int64Value(int64(hugepage.Count)),
+			})
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hugepages"), hugepages)...)
+	}
 }
 
 func (r *TenantResource) tenantResourceModeltoState(ctx context.Context, respData *f5ossdk.F5RespTenants, data *TenantResourceModel) {
@@ -394,6 +1117,13 @@ func (r *TenantResource) tenantResourceModeltoState(ctx context.Context, respDat
 	data.Nodes, _ = types.ListValueFrom(ctx, types.Int64Type, respData.F5TenantsTenant[0].Config.Nodes)
 	data.MgmtGateway = types.StringValue(respData.F5TenantsTenant[0].State.Gateway)
 	data.Status = types.StringValue(respData.F5TenantsTenant[0].State.Status)
+	text, labels := decodeLabels(respData.F5TenantsTenant[0].Config.Description)
+	if text != "" || !data.Description.IsNull() {
+		data.Description = types.StringValue(text)
+	}
+	if len(labels) > 0 || !data.Labels.IsNull() {
+		data.Labels, _ = types.MapValueFrom(ctx, types.StringType, labels)
+	}
 	data.DagIpv6prefixLength = types.Int64Value(int64(respData.F5TenantsTenant[0].State.DagIpv6PrefixLength))
 	if respData.F5TenantsTenant[0].State.MacData.MacPoolSize == 1 {
 		data.MacBlockSize = types.StringValue("one")
@@ -417,6 +1147,42 @@ func (r *TenantResource) tenantResourceModeltoState(ctx context.Context, respDat
 		data.Memory = types.Int64Value(int64(memoryInt))
 	}
 	data.Cryptos = types.StringValue(respData.F5TenantsTenant[0].State.Cryptos)
+	if !data.Hugepages.IsNull() && len(respData.F5TenantsTenant[0].Config.Hugepages) > 0 {
+		var hugepages []TenantHugepagesModel
+		for _, hugepage := range respData.F5TenantsTenant[0].Config.Hugepages {
+			hugepages = append(hugepages, TenantHugepagesModel{
+				Size:  types.StringValue(hugepage.Size),
+				Count: types.Int64Value(int64(hugepage.Count)),
+			})
+		}
+		data.Hugepages, _ = types.ListValueFrom(ctx, tenantHugepagesType, hugepages)
+	}
+}
+
+// tenantMemory returns the configured tenant memory in MB, or the memory F5OS sizes for the
+// configured vCPUs on the platform.
+func (r *TenantResource) tenantMemory(ctx context.Context, data *TenantResourceModel) int {
+	if !data.Memory.IsNull() && !data.Memory.IsUnknown() {
+		return int(data.Memory.ValueInt64())
+	}
+	tflog.Info(ctx, fmt.Sprintf("r.client.PlatformType:%+v", r.client.PlatformType))
+	if r.client.PlatformType == "r2800" || r.client.PlatformType == "r2000" || r.client.PlatformType == "r4000" || r.client.PlatformType == "r4800" {
+		return 3 * 1024 * int(data.CpuCores.ValueInt64())
+	}
+	return (3.5 * 1024 * int(data.CpuCores.ValueInt64())) + (512)
+}
+
+func tenantHugepages(ctx context.Context, data *TenantResourceModel) []f5ossdk.F5TenantHugepages {
+	var hugepages []TenantHugepagesModel
+	data.Hugepages.ElementsAs(ctx, &hugepages, false)
+	var tenantHugepages []f5ossdk.F5TenantHugepages
+	for _, hugepage := range hugepages {
+		tenantHugepages = append(tenantHugepages, f5ossdk.F5TenantHugepages{
+			Size:  hugepage.Size.ValueString(),
+			Count: int(hugepage.Count.ValueInt64()),
+		})
+	}
+	return tenantHugepages
 }
 
 func (r *TenantResource) getTenantCreateConfig(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) *f5ossdk.F5ReqTenants {
@@ -442,27 +1208,40 @@ func (r *TenantResource) getTenantCreateConfig(ctx context.Context, req resource
 	// 	tenantSubbj.Config.MacData.F5TenantL2InlineMacBlockSize = data.MacBlockSize.ValueString()
 	// }
 	// tenantSubbj.Config.MacData.F5TenantL2InlineMacBlockSize = data.MacBlockSize.ValueString()
-	if !data.Memory.IsNull() && !data.Memory.IsUnknown() {
-		tenantSubbj.Config.Memory = int(data.Memory.ValueInt64())
-	} else {
-		tflog.Info(ctx, fmt.Sprintf("r.client.PlatformType:%+v", r.client.PlatformType))
-		if r.client.PlatformType == "r2800" || r.client.PlatformType == "r2000" || r.client.PlatformType == "r4000" || r.client.PlatformType == "r4800" {
-			tenantSubbj.Config.Memory = 3 * 1024 * int(data.CpuCores.ValueInt64())
-		} else {
-			tenantSubbj.Config.Memory = (3.5 * 1024 * int(data.CpuCores.ValueInt64())) + (512)
-		}
-	}
+	tenantSubbj.Config.Memory = r.tenantMemory(ctx, data)
+	tenantSubbj.Config.Hugepages = tenantHugepages(ctx, data)
 
 	// tenantSubbj.Config.Memory = 3.5*1024*int(data.CpuCores.ValueInt64()) + (512)
 	// } else {
 
 	// }
 	data.Vlans.ElementsAs(ctx, &tenantSubbj.Config.Vlans, false)
+	if !data.CloneFrom.IsNull() {
+		// the clone gets the VLANs, memory and hugepages of its source unless configured
+		source, err := r.tenantCloneSource(data.CloneFrom.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("F5OS Client Error", err.Error())
+			return nil
+		}
+		if data.Vlans.IsNull() {
+			tenantSubbj.Config.Vlans = source.Config.Vlans
+		}
+		if memory := tenantRespMemory(source); data.Memory.IsNull() && memory > 0 {
+			tenantSubbj.Config.Memory = memory
+		}
+		if data.Hugepages.IsNull() {
+			tenantSubbj.Config.Hugepages = source.Config.Hugepages
+		}
+		if data.DeploymentFile.IsNull() {
+			tenantSubbj.Config.DeploymentFile = source.Config.DeploymentFile
+		}
+	}
 	tenantSubbj.Config.PrefixLength = int(data.MgmtPrefix.ValueInt64())
 	tenantSubbj.Config.RunningState = data.RunningState.ValueString()
 	tenantSubbj.Config.Cryptos = data.Cryptos.ValueString()
 	data.Nodes.ElementsAs(ctx, &tenantSubbj.Config.Nodes, false)
 	tenantSubbj.Config.Storage.Size = int(data.VirtualdiskSize.ValueInt64())
+	tenantSubbj.Config.Description = tenantDescription(ctx, data, &resp.Diagnostics)
 
 	tenantConfig := new(f5ossdk.F5ReqTenants)
 	tenantConfig.F5TenantsTenant = append(tenantConfig.F5TenantsTenant, tenantSubbj)
@@ -485,15 +1264,8 @@ func (r *TenantResource) getTenantUpdateConfig(ctx context.Context, req resource
 	tenantSubbj.Config.VcpuCoresPerNode = int(data.CpuCores.ValueInt64())
 	tenantSubbj.Config.DagIpv6PrefixLength = int(data.DagIpv6prefixLength.ValueInt64())
 	tenantSubbj.Config.MacData.F5TenantL2InlineMacBlockSize = data.MacBlockSize.ValueString()
-	if !data.Memory.IsNull() && !data.Memory.IsUnknown() {
-		tenantSubbj.Config.Memory = int(data.Memory.ValueInt64())
-	} else {
-		if r.client.PlatformType == "r2800" || r.client.PlatformType == "r2000" || r.client.PlatformType == "r4000" || r.client.PlatformType == "r4800" {
-			tenantSubbj.Config.Memory = 3 * 1024 * int(data.CpuCores.ValueInt64())
-		} else {
-			tenantSubbj.Config.Memory = (3.5 * 1024 * int(data.CpuCores.ValueInt64())) + (512)
-		}
-	}
+	tenantSubbj.Config.Memory = r.tenantMemory(ctx, data)
+	tenantSubbj.Config.Hugepages = tenantHugepages(ctx, data)
 	data.Nodes.ElementsAs(ctx, &tenantSubbj.Config.Nodes, false)
 	data.Vlans.ElementsAs(ctx, &tenantSubbj.Config.Vlans, false)
 	tenantSubbj.Config.PrefixLength = int(data.MgmtPrefix.ValueInt64())
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &TenantsDataSource{}
+)
+
+func NewTenantsDataSource() datasource.DataSource {
+	return &TenantsDataSource{}
+}
+
+// TenantsDataSource defines the data source implementation.
+type TenantsDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// TenantsDataSourceModel describes the data source data model.
+type TenantsDataSourceModel struct {
+	ID         types.String  `tfsdk:"id"`
+	Labels     types.Map     `tfsdk:"labels"`
+	NamePrefix types.String  `tfsdk:"name_prefix"`
+	Names      types.List    `tfsdk:"names"`
+	Tenants    []TenantModel `tfsdk:"tenants"`
+}
+
+type TenantModel struct {
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	Description  types.String `tfsdk:"description"`
+	Labels       types.Map    `tfsdk:"labels"`
+	RunningState types.String `tfsdk:"running_state"`
+	Status       types.String `tfsdk:"status"`
+	Nodes        types.List   `tfsdk:"nodes"`
+	Vlans        types.List   `tfsdk:"vlans"`
+}
+
+func (d *TenantsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenants"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *TenantsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the tenants of F5OS based systems like chassis partitions or rSeries platforms, selected by their labels.\n\n" +
+			"The labels are the ones set by the `labels` attribute of `f5os_tenant`, stored in the description of the tenants. " +
+			"Select the tenants of a team with its labels, for example to give each team of a shared chassis the tenants it owns without listing their names.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"labels": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Labels the tenants must all have, with the same values, for example `{ team = \"web\" }`. Every tenant is listed when not set.",
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the tenants whose name starts with this prefix.",
+			},
+			"names": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the tenants of `tenants`.",
+			},
+			"tenants": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of the tenants selected, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the tenant.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the tenant, for example `BIG-IP`.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Description of the tenant, without its labels.",
+						},
+						"labels": schema.MapAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Labels of the tenant.",
+						},
+						"running_state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Running state of the tenant, `configured`, `provisioned` or `deployed`.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Status of the tenant, for example `Running`.",
+						},
+						"nodes": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.Int64Type,
+							MarkdownDescription: "Blades the tenant is deployed on.",
+						},
+						"vlans": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.Int64Type,
+							MarkdownDescription: "IDs of the VLANs of the tenant.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TenantsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *TenantsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TenantsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client.PlatformType == "Velos Controller" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_tenants` data source is supported with Velos Partition level/rSeries appliance.")
+		return
+	}
+	selector := labelsMap(ctx, data.Labels, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tenants, err := labeledTenants(d.client, data.NamePrefix.ValueString(), selector)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Unable to List Tenants, got error: %s", err))
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Found %d tenants with labels %v", len(tenants), selector))
+
+	data.Tenants = []TenantModel{}
+	names := []string{}
+	for _, tenant := range tenants {
+		text, labels := decodeLabels(tenant.Config.Description)
+		model := TenantModel{
+			Name:         types.StringValue(tenant.Name),
+			Type:         types.StringValue(tenant.Config.Type),
+			Description:  types.StringValue(text),
+			RunningState: types.StringValue(tenant.State.RunningState),
+			Status:       types.StringValue(tenant.State.Status),
+		}
+		var diags diag.Diagnostics
+		model.Labels, diags = types.MapValueFrom(ctx, types.StringType, labels)
+		resp.Diagnostics.Append(diags...)
+		model.Nodes, diags = types.ListValueFrom(ctx, types.Int64Type, tenant.Config.Nodes)
+		resp.Diagnostics.Append(diags...)
+		model.Vlans, diags = types.ListValueFrom(ctx, types.Int64Type, tenant.Config.Vlans)
+		resp.Diagnostics.Append(diags...)
+		data.Tenants = append(data.Tenants, model)
+		names = append(names, tenant.Name)
+	}
+	var diags diag.Diagnostics
+	data.Names, diags = types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	data.ID = types.StringValue(fmt.Sprintf("%s-tenants", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// labeledTenants returns the tenants of the system whose name starts with prefix and having the
+// labels of selector, sorted by name.
+func labeledTenants(client *f5ossdk.F5os, prefix string, selector map[string]string) ([]f5ossdk.F5RespTenant, error) {
+	tenants, err := client.GetTenants()
+	if err != nil {
+		return nil, err
+	}
+	var selected []f5ossdk.F5RespTenant
+	for _, tenant := range tenants.Tenants.Tenant {
+		_, labels := decodeLabels(tenant.Config.Description)
+		if strings.HasPrefix(tenant.Name, prefix) && matchLabels(labels, selector) {
+			selected = append(selected, tenant)
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool { return selected[i].Name < selected[j].Name })
+	return selected, nil
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccTenantsDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTenantsDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_tenants.web", "tenants.#"),
+					resource.TestCheckResourceAttrSet("data.f5os_tenants.web", "names.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTenantsDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/f5-tenants:tenants", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", testAccTenantsDatasourceTenants)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTenantsDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_tenants.web", "tenants.#", "2"),
+					resource.TestCheckResourceAttr("data.f5os_tenants.web", "names.0", "web-1"),
+					resource.TestCheckResourceAttr("data.f5os_tenants.web", "names.1", "web-2"),
+					resource.TestCheckResourceAttr("data.f5os_tenants.web", "tenants.0.description", "Web frontend"),
+					resource.TestCheckResourceAttr("data.f5os_tenants.web", "tenants.0.labels.env", "prod"),
+					resource.TestCheckResourceAttr("data.f5os_tenants.web", "tenants.0.status", "Running"),
+					resource.TestCheckResourceAttr("data.f5os_tenants.web", "tenants.0.vlans.#", "2"),
+					resource.TestCheckResourceAttr("data.f5os_tenants.web", "tenants.1.description", ""),
+				),
+			},
+			{
+				Config: testAccTenantsDatasourceAllConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_tenants.all", "tenants.#", "3"),
+					resource.TestCheckResourceAttr("data.f5os_tenants.all", "names.0", "db-1"),
+					resource.TestCheckResourceAttr("data.f5os_tenants.all", "tenants.0.description", "Managed by hand [see runbook]"),
+					resource.TestCheckResourceAttr("data.f5os_tenants.all", "tenants.0.labels.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTenantsDatasourceConfig = `
+data "f5os_tenants" "web" {
+  labels = {
+    team = "web"
+  }
+}
+`
+
+const testAccTenantsDatasourceAllConfig = `
+data "f5os_tenants" "all" {
+}
+`
+
+const testAccTenantsDatasourceTenants = `{"f5-tenants:tenants": {"tenant": [
+	{"name": "web-2", "config": {"type": "BIG-IP", "description": "[team=web]", "vlans": [20]}, "state": {"running-state": "deployed", "status": "Running"}},
+	{"name": "db-1", "config": {"type": "BIG-IP", "description": "Managed by hand [see runbook]", "vlans": [30]}, "state": {"running-state": "deployed", "status": "Running"}},
+	{"name": "web-1", "config": {"type": "BIG-IP", "description": "Web frontend [env=prod,team=web]", "nodes": [1], "vlans": [10, 20]}, "state": {"running-state": "deployed", "status": "Running"}}]}}`
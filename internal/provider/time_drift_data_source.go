@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &TimeDriftDataSource{}
+)
+
+func NewTimeDriftDataSource() datasource.DataSource {
+	return &TimeDriftDataSource{}
+}
+
+// TimeDriftDataSource defines the data source implementation.
+type TimeDriftDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// TimeDriftDataSourceModel describes the data source data model.
+type TimeDriftDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	MaxDrift        types.Int64  `tfsdk:"max_drift"`
+	DeviceTime      types.String `tfsdk:"device_time"`
+	LocalTime       types.String `tfsdk:"local_time"`
+	Drift           types.Int64  `tfsdk:"drift"`
+	WithinTolerance types.Bool   `tfsdk:"within_tolerance"`
+}
+
+func (d *TimeDriftDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_time_drift"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *TimeDriftDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Compare the clock of the F5OS device with the clock of the machine running Terraform.\n\n" +
+			"Use this data source to gate certificate and licensing operations, which fail when the device clock is off, on an acceptable time drift.",
+
+		Attributes: map[string]schema.Attribute{
+			"max_drift": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Largest number of seconds the device clock may be ahead or behind the local clock.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"device_time": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Current time of the device clock, in RFC 3339 format.",
+			},
+			"local_time": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the local clock when the device clock was read, in RFC 3339 format.",
+			},
+			"drift": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of seconds the device clock is ahead of the local clock, negative when it is behind.",
+			},
+			"within_tolerance": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Set to `true` when the absolute `drift` does not exceed `max_drift`.\nNot set when `max_drift` is not configured.",
+			},
+		},
+	}
+}
+
+func (d *TimeDriftDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *TimeDriftDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TimeDriftDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deviceTime, localTime, err := d.client.GetDeviceTime()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Get Device Time", fmt.Sprintf("Error:%s", err))
+		return
+	}
+	// the device reports whole seconds
+	drift := deviceTime.Sub(localTime).Round(time.Second)
+	tflog.Debug(ctx, fmt.Sprintf("Device time :%+v, local time :%+v, drift :%+v", deviceTime, localTime, drift))
+	data.DeviceTime = types.StringValue(deviceTime.Format(time.RFC3339))
+	data.LocalTime = types.StringValue(localTime.Format(time.RFC3339))
+	data.Drift = types.Int64Value(int64(drift.Seconds()))
+	data.WithinTolerance = types.BoolNull()
+	if !data.MaxDrift.IsNull() {
+		data.WithinTolerance = types.BoolValue(drift.Abs() <= time.Duration(data.MaxDrift.ValueInt64())*time.Second)
+	}
+	data.ID = types.StringValue(fmt.Sprintf("%s-time-drift", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
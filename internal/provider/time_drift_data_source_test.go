@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccTimeDriftDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTimeDriftDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_time_drift.test", "device_time"),
+					resource.TestCheckResourceAttrSet("data.f5os_time_drift.test", "drift"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTimeDriftDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	// the device clock runs 5 minutes ahead
+	mux.HandleFunc("/restconf/data/openconfig-system:system/state/current-datetime", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"openconfig-system:current-datetime": "%s"}`, time.Now().UTC().Add(5*time.Minute).Format(time.RFC3339))
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTimeDriftDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_time_drift.test", "device_time"),
+					resource.TestCheckResourceAttr("data.f5os_time_drift.test", "drift", "300"),
+					resource.TestCheckNoResourceAttr("data.f5os_time_drift.test", "within_tolerance"),
+				),
+			},
+			{
+				Config: testAccTimeDriftMaxDriftDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_time_drift.test", "within_tolerance", "false"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTimeDriftDatasourceConfig = `
+data "f5os_time_drift" "test" {}
+`
+
+const testAccTimeDriftMaxDriftDatasourceConfig = `
+data "f5os_time_drift" "test" {
+  max_drift = 60
+}
+`
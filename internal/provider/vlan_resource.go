@@ -133,6 +133,16 @@ func (r *VlanResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 	tflog.Info(ctx, fmt.Sprintf("[READ] Vlan :%+v", vlanId))
+	exists, err := r.client.VlanExists(vlanId)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("%v", err), fmt.Sprintf("Unable to Read/Get Vlan ID:%d", vlanId))
+		return
+	}
+	if !exists {
+		tflog.Warn(ctx, fmt.Sprintf("Vlan %d no longer on the device, removing it from state", vlanId))
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	partData, err := r.client.GetVlan(vlanId)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("%v", err), fmt.Sprintf("Unable to Read/Get Vlan ID:%d", vlanId))
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -133,6 +134,10 @@ func TestAccVlanCreateUnitTC2Resource(t *testing.T) {
 		_, _ = fmt.Fprintf(w, ``)
 	})
 	mux.HandleFunc("/restconf/data/openconfig-vlan:vlans/vlan=400", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "HEAD" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
 		if r.Method == "GET" && (count == 0 || count == 1 || count == 2) {
 			w.WriteHeader(http.StatusOK)
 			_, _ = fmt.Fprintf(w, `{"openconfig-vlan:vlan": [{
@@ -178,6 +183,158 @@ func TestAccVlanCreateUnitTC2Resource(t *testing.T) {
 	})
 }
 
+func TestAccVlanCreateUnitTC3Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/f5os/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/f5os/restconf/data/openconfig-vlan:vlans", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		_, _ = fmt.Fprintf(w, ``)
+	})
+	mux.HandleFunc("/f5os/restconf/data/openconfig-vlan:vlans/vlan=400", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"openconfig-vlan:vlan": [{"vlan-id": 400, "config": {"vlan-id": 400, "name": "mytestvlan2"}}]}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVlanBasePathProviderConfig + testAccVlanCreateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_vlan.vlan-id", "id", "400"),
+					resource.TestCheckResourceAttr("f5os_vlan.vlan-id", "name", "mytestvlan2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVlanOutOfBandDeleteUnitTC4Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	var deleted = false
+	var patched = 0
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-vlan:vlans", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		deleted = false
+		patched++
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/restconf/data/openconfig-vlan:vlans/vlan=400", func(w http.ResponseWriter, r *http.Request) {
+		// the device refuses HEAD, the presence check falls back to a depth limited GET
+		if r.Method == "HEAD" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if r.Method == "DELETE" {
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if deleted {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprintf(w, "%s", `{"ietf-restconf:errors": {"error": [{"error-type": "application", "error-tag": "invalid-value", "error-message": "uri keypath not found"}]}}`)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"openconfig-vlan:vlan": [{"vlan-id": 400, "config": {"vlan-id": 400, "name": "mytestvlan2"}}]}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVlanCreateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_vlan.vlan-id", "name", "mytestvlan2"),
+				),
+			},
+			{
+				// VLAN removed outside Terraform, it is recreated instead of failing the refresh
+				PreConfig: func() { deleted = true },
+				Config:    testAccVlanCreateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_vlan.vlan-id", "name", "mytestvlan2"),
+					func(s *terraform.State) error {
+						if patched != 2 {
+							return fmt.Errorf("expected the VLAN to be recreated, got %d VLAN configurations", patched)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccVlanAcceptedUnitTC5Resource(t *testing.T) {
+	testAccPreUnitCheck(t)
+	var deleted = false
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	// the configuration is accepted for processing, without a body
+	mux.HandleFunc("/restconf/data/openconfig-vlan:vlans", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method, "Expected method 'PATCH', got %s", r.Method)
+		deleted = false
+		w.WriteHeader(http.StatusAccepted)
+	})
+	mux.HandleFunc("/restconf/data/openconfig-vlan:vlans/vlan=400", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if deleted {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"openconfig-vlan:vlan": [{"vlan-id": 400, "config": {"vlan-id": 400, "name": "mytestvlan2"}}]}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVlanCreateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("f5os_vlan.vlan-id", "id", "400"),
+					resource.TestCheckResourceAttr("f5os_vlan.vlan-id", "name", "mytestvlan2"),
+				),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if !deleted {
+				return fmt.Errorf("expected the VLAN to be deleted")
+			}
+			return nil
+		},
+	})
+}
+
+const testAccVlanBasePathProviderConfig = `
+provider "f5os" {
+  restconf_base_path = "/f5os/restconf/data/"
+}
+`
+
 const testAccVlanCreateResourceConfig = `
 resource "f5os_vlan" "vlan-id" {
  vlan_id = 400
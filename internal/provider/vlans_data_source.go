@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	f5ossdk "gitswarm.f5net.com/terraform-providers/f5osclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ datasource.DataSource = &VlansDataSource{}
+)
+
+func NewVlansDataSource() datasource.DataSource {
+	return &VlansDataSource{}
+}
+
+// VlansDataSource defines the data source implementation.
+type VlansDataSource struct {
+	client   *f5ossdk.F5os
+	teemData *TeemData
+}
+
+// VlansDataSourceModel describes the data source data model.
+type VlansDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Labels     types.Map    `tfsdk:"labels"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	VlanIds    types.List   `tfsdk:"vlan_ids"`
+	Vlans      []VlanModel  `tfsdk:"vlans"`
+}
+
+type VlanModel struct {
+	VlanId  types.Int64  `tfsdk:"vlan_id"`
+	Name    types.String `tfsdk:"name"`
+	Tenants types.List   `tfsdk:"tenants"`
+}
+
+func (d *VlansDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vlans"
+	teemData := &TeemData{}
+	teemData.ProviderName = req.ProviderTypeName
+	teemData.ResourceName = resp.TypeName
+	d.teemData = teemData
+}
+
+func (d *VlansDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the VLANs of F5OS based systems like chassis partitions or rSeries platforms, selected by the labels of the tenants they are assigned to.\n\n" +
+			"F5OS VLANs only have an ID and a name, they cannot carry labels: a VLAN belongs to the teams of the tenants using it, " +
+			"the tenants labeled with the `labels` attribute of `f5os_tenant`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of this data source",
+			},
+			"labels": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only list the VLANs assigned to at least one tenant having all these labels, for example `{ team = \"web\" }`. Every VLAN is listed when not set.",
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the VLANs whose name starts with this prefix.",
+			},
+			"vlan_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of the VLANs of `vlans`, for example for the `vlans` of a new tenant of the team.",
+			},
+			"vlans": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of the VLANs selected, sorted by VLAN ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"vlan_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "ID of the VLAN.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the VLAN.",
+						},
+						"tenants": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Names of the tenants the VLAN is assigned to, whatever their labels.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VlansDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client, resp.Diagnostics = toF5osProvider(req.ProviderData)
+}
+
+func (d *VlansDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VlansDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client.PlatformType == "Velos Controller" {
+		resp.Diagnostics.AddError("Client Error", "`f5os_vlans` data source is supported with Velos Partition level/rSeries appliance.")
+		return
+	}
+	selector := labelsMap(ctx, data.Labels, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	vlans, err := d.client.GetVlans()
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Unable to List VLANs, got error: %s", err))
+		return
+	}
+	tenants, err := labeledTenants(d.client, "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("F5OS Client Error:", fmt.Sprintf("Unable to List Tenants, got error: %s", err))
+		return
+	}
+	// the tenants of every VLAN, and the VLANs of the tenants having the labels
+	vlanTenants := make(map[int][]string)
+	labeled := make(map[int]bool)
+	for _, tenant := range tenants {
+		_, labels := decodeLabels(tenant.Config.Description)
+		for _, vlanId := range tenant.Config.Vlans {
+			vlanTenants[vlanId] = append(vlanTenants[vlanId], tenant.Name)
+			if matchLabels(labels, selector) {
+				labeled[vlanId] = true
+			}
+		}
+	}
+	sort.Slice(vlans, func(i, j int) bool { return vlans[i].VlanID < vlans[j].VlanID })
+
+	data.Vlans = []VlanModel{}
+	vlanIds := []int{}
+	for _, vlan := range vlans {
+		if !strings.HasPrefix(vlan.Config.Name, data.NamePrefix.ValueString()) || (len(selector) > 0 && !labeled[vlan.VlanID]) {
+			continue
+		}
+		model := VlanModel{
+			VlanId: types.Int64Value(int64(vlan.VlanID)),
+			Name:   types.StringValue(vlan.Config.Name),
+		}
+		var diags diag.Diagnostics
+		model.Tenants, diags = types.ListValueFrom(ctx, types.StringType, append([]string{}, vlanTenants[vlan.VlanID]...))
+		resp.Diagnostics.Append(diags...)
+		data.Vlans = append(data.Vlans, model)
+		vlanIds = append(vlanIds, vlan.VlanID)
+	}
+	tflog.Info(ctx, fmt.Sprintf("Found %d VLANs with labels %v", len(data.Vlans), selector))
+	var diags diag.Diagnostics
+	data.VlanIds, diags = types.ListValueFrom(ctx, types.Int64Type, vlanIds)
+	resp.Diagnostics.Append(diags...)
+	data.ID = types.StringValue(fmt.Sprintf("%s-vlans", d.client.Host))
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccVlansDataSourceTC1(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVlansDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.f5os_vlans.web", "vlans.#"),
+					resource.TestCheckResourceAttrSet("data.f5os_vlans.web", "vlan_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVlansDataSourceUnitTC1(t *testing.T) {
+	testAccPreUnitCheck(t)
+	mux.HandleFunc("/restconf/data/openconfig-system:system/aaa", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		w.Header().Set("X-Auth-Token", "eyJhbGciOiJIXzI2NiIsInR6cCI6IkcXVCJ9")
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/f5os_auth.json"))
+	})
+	mux.HandleFunc("/restconf/data/openconfig-platform:components/component=platform/state/description", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, "%s", loadFixtureString("./fixtures/platform_state.json"))
+	})
+	mux.HandleFunc("/restconf/data/f5-tenants:tenants", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", testAccTenantsDatasourceTenants)
+	})
+	mux.HandleFunc("/restconf/data/openconfig-vlan:vlans", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method, "Expected method 'GET', got %s", r.Method)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, "%s", `{"openconfig-vlan:vlans": {"vlan": [
+			{"vlan-id": 30, "config": {"vlan-id": 30, "name": "db-internal"}},
+			{"vlan-id": 20, "config": {"vlan-id": 20, "name": "web-internal"}},
+			{"vlan-id": 40, "config": {"vlan-id": 40, "name": "spare"}},
+			{"vlan-id": 10, "config": {"vlan-id": 10, "name": "web-external"}}]}}`)
+	})
+	defer teardown()
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVlansDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_vlans.web", "vlans.#", "2"),
+					resource.TestCheckResourceAttr("data.f5os_vlans.web", "vlan_ids.0", "10"),
+					resource.TestCheckResourceAttr("data.f5os_vlans.web", "vlan_ids.1", "20"),
+					resource.TestCheckResourceAttr("data.f5os_vlans.web", "vlans.1.name", "web-internal"),
+					resource.TestCheckResourceAttr("data.f5os_vlans.web", "vlans.1.tenants.#", "2"),
+					resource.TestCheckResourceAttr("data.f5os_vlans.web", "vlans.1.tenants.0", "web-1"),
+				),
+			},
+			{
+				Config: testAccVlansDatasourcePrefixConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.f5os_vlans.spare", "vlans.#", "1"),
+					resource.TestCheckResourceAttr("data.f5os_vlans.spare", "vlans.0.vlan_id", "40"),
+					resource.TestCheckResourceAttr("data.f5os_vlans.spare", "vlans.0.tenants.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVlansDatasourceConfig = `
+data "f5os_vlans" "web" {
+  labels = {
+    team = "web"
+  }
+}
+`
+
+const testAccVlansDatasourcePrefixConfig = `
+data "f5os_vlans" "spare" {
+  name_prefix = "spare"
+}
+`
@@ -1,11 +1,10 @@
 package main
 
 import (
-	"context"
 	"flag"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"gitswarm.f5net.com/terraform-providers/terraform-provider-f5os/internal/provider"
 )
 
@@ -34,14 +33,14 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		// TODO: Update this string with the published name of your provider.
-		Address: "registry.terraform.io/f5networks/f5os",
-		//registry.terraform.io/f5networks/f5os",
-		Debug: debug,
+	var opts []tf6server.ServeOpt
+	if debug {
+		opts = append(opts, tf6server.WithManagedDebug())
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	// the provider server sets the log level of the resource and data source operations
+	err := tf6server.Serve("registry.terraform.io/f5networks/f5os", provider.NewProtocol6(version), opts...)
+	provider.CloseAuditLogs()
 
 	if err != nil {
 		log.Fatal(err.Error())